@@ -0,0 +1,152 @@
+//Command partyfuzz plays random games on every MP1 board with every
+//GameConfig, checking the engine's invariants after every event, until it
+//is stopped. Every new kind of failure is minimized and written to the
+//output directory as a replay log, which fuzz.Check replays.
+//
+//Usage:
+//
+//	partyfuzz [-duration 1h] [-games N] [-seed N] [-turns 20,35,50] [-out DIR]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	_ "github.com/0xhexnumbers/partysim/mp1/board"
+	"github.com/0xhexnumbers/partysim/mp1/fuzz"
+)
+
+func main() {
+	err := run(os.Args[1:], registered(), os.Stdout)
+	if err != nil && err != flag.ErrHelp {
+		fmt.Fprintln(os.Stderr, "partyfuzz:", err)
+		os.Exit(1)
+	}
+}
+
+//target is a board to play and the name its logs use.
+type target struct {
+	name  string
+	board mp1.Board
+}
+
+//registered returns every registered board, sorted by name.
+func registered() []target {
+	var targets []target
+	for _, name := range mp1.BoardNames() {
+		b, _ := mp1.LookupBoard(name)
+		targets = append(targets, target{name, b})
+	}
+	return targets
+}
+
+//run parses args and plays games on boards until the duration or number
+//of games runs out.
+func run(args []string, boards []target, out io.Writer) error {
+	fs := flag.NewFlagSet("partyfuzz", flag.ContinueOnError)
+	fs.SetOutput(out)
+	duration := fs.Duration("duration", 0, "stop after this long, 0 to "+
+		"play until stopped")
+	games := fs.Int("games", 0, "stop after this many games, 0 to play "+
+		"until stopped")
+	seed := fs.Int64("seed", 0, "seed of the first round of games")
+	turnList := fs.String("turns", "20,35,50", "comma separated numbers "+
+		"of turns to play")
+	dir := fs.String("out", ".", "directory to write failing logs to")
+	maxSteps := fs.Int("max-steps", fuzz.DefaultMaxSteps, "events a game "+
+		"may take before it fails to terminate")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	turns, err := parseTurns(*turnList)
+	if err != nil {
+		return err
+	}
+
+	var deadline time.Time
+	if *duration > 0 {
+		deadline = time.Now().Add(*duration)
+	}
+	played, failed := 0, 0
+	seen := map[string]bool{}
+	defer func() {
+		fmt.Fprintf(out, "%d games, %d failures\n", played, failed)
+	}()
+	for s := *seed; ; s++ {
+		for _, t := range turns {
+			for _, b := range boards {
+				for _, config := range fuzz.Configs(t) {
+					if *games > 0 && played == *games ||
+						!deadline.IsZero() && time.Now().After(deadline) {
+						return nil
+					}
+					played++
+					_, err := fuzz.Play(b.board, fuzz.Case{
+						Board:  b.name,
+						Config: config,
+						Seed:   s,
+					}, *maxSteps)
+					f, ok := err.(*fuzz.Failure)
+					if !ok {
+						if err != nil {
+							return err
+						}
+						continue
+					}
+					failed++
+					//Only the first failure of each kind on each event
+					//type is kept.
+					key := fmt.Sprintf("%s %T", f.Kind(), f.Event)
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+					path, err := writeFailure(*dir, fuzz.Minimize(f),
+						len(seen))
+					if err != nil {
+						return err
+					}
+					fmt.Fprintf(out, "seed %d: %v\n\twrote %s\n", s, f, path)
+				}
+			}
+		}
+	}
+}
+
+//parseTurns parses a comma separated list of turn counts.
+func parseTurns(list string) ([]uint8, error) {
+	var turns []uint8
+	for _, field := range strings.Split(list, ",") {
+		t, err := strconv.ParseUint(strings.TrimSpace(field), 10, 8)
+		if err != nil || t == 0 {
+			return nil, fmt.Errorf("turns must be between 1 and 255, "+
+				"got %q", field)
+		}
+		turns = append(turns, uint8(t))
+	}
+	return turns, nil
+}
+
+//writeFailure writes the log of f, the nth failure kept, to a file in dir
+//and returns its path.
+func writeFailure(dir string, f *fuzz.Failure, n int) (string, error) {
+	name := fmt.Sprintf("%d-%s-%s.json", n, f.Log.Board,
+		strings.ReplaceAll(f.Kind(), " ", "-"))
+	path := filepath.Join(dir, name)
+	w, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	err = mp1.WriteReplay(w, f.Log)
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	return path, err
+}
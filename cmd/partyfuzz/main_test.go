@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	"github.com/0xhexnumbers/partysim/mp1/fuzz"
+)
+
+//brokenSpace takes a star from everyone who lands on it, even if they
+//have none.
+var brokenSpace = mp1.Space{Type: mp1.Happening,
+	StoppingEvent: func(g *mp1.Game, player int) {
+		g.AwardStars(player, -1)
+	},
+}
+
+var brokenBoard = mp1.Board{
+	Chains: &[]mp1.Chain{
+		{{Type: mp1.Start}, brokenSpace, brokenSpace, brokenSpace},
+	},
+	Links: &map[int]*[]mp1.ChainSpace{
+		0: {mp1.NewChainSpace(0, 0)},
+	},
+}
+
+func TestParseTurns(t *testing.T) {
+	turns, err := parseTurns("20, 35,50")
+	if err != nil || len(turns) != 3 || turns[1] != 35 {
+		t.Errorf("Expected [20 35 50], got: %v, %v", turns, err)
+	}
+	for _, list := range []string{"", "0", "256", "20,x"} {
+		if _, err := parseTurns(list); err == nil {
+			t.Errorf("Expected %q to fail", list)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	var out bytes.Buffer
+	bmm, _ := mp1.LookupBoard("BMM")
+	boards := []target{{"BMM", bmm}, {"Broken", brokenBoard}}
+	//The first 128 games are played on BMM, the next 128 on Broken.
+	args := []string{"-games", "256", "-turns", "20", "-out", dir}
+	err := run(args, boards, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(out.String(), "256 games, 128 failures\n") {
+		t.Errorf("Expected 128 failures, got: %s", out.String())
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("Expected 1 log, got: %v", files)
+	}
+	r, err := os.Open(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	l, err := mp1.ReadReplay(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fuzz.CheckBoard(brokenBoard, l).(*fuzz.Failure); !ok {
+		t.Errorf("Expected %s to replay a failure", files[0])
+	}
+}
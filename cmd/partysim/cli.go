@@ -0,0 +1,278 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//errQuit is returned by ask when the user quits the game.
+var errQuit = errors.New("quit")
+
+//session walks a user through a game, reading answers from in and
+//writing prompts to out.
+type session struct {
+	in  *bufio.Scanner
+	out io.Writer
+	g   *mp1.Game
+}
+
+func newSession(in io.Reader, out io.Writer) *session {
+	return &session{in: bufio.NewScanner(in), out: out}
+}
+
+//readLine prompts the user and returns their trimmed answer.
+func (s *session) readLine(prompt string) (string, error) {
+	fmt.Fprint(s.out, prompt)
+	if !s.in.Scan() {
+		if err := s.in.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+	return strings.TrimSpace(s.in.Text()), nil
+}
+
+//pickBoard asks the user to pick one of the registered boards.
+func (s *session) pickBoard() (mp1.Board, error) {
+	names := mp1.BoardNames()
+	fmt.Fprintln(s.out, "Boards:")
+	for i, name := range names {
+		fmt.Fprintf(s.out, "  %d) %s\n", i+1, name)
+	}
+	for {
+		line, err := s.readLine("Pick a board: ")
+		if err != nil {
+			return mp1.Board{}, err
+		}
+		if b, ok := mp1.LookupBoard(strings.ToUpper(line)); ok {
+			return b, nil
+		}
+		if i, err := strconv.Atoi(line); err == nil && i >= 1 && i <= len(names) {
+			b, _ := mp1.LookupBoard(names[i-1])
+			return b, nil
+		}
+		fmt.Fprintf(s.out, "Unknown board %q\n", line)
+	}
+}
+
+//pickChars asks for the name of every character that is not set yet.
+func (s *session) pickChars(chars []string) error {
+	for i := range s.g.Players {
+		if i < len(chars) && chars[i] != "" {
+			s.g.Players[i].Char = chars[i]
+			continue
+		}
+		def := fmt.Sprintf("Player %d", i+1)
+		line, err := s.readLine(fmt.Sprintf("Character for player %d [%s]: ",
+			i+1, def))
+		if err != nil {
+			return err
+		}
+		if line == "" {
+			line = def
+		}
+		s.g.Players[i].Char = line
+	}
+	return nil
+}
+
+//play asks every question of the game until it is over or the user
+//quits. Standings are shown after every turn.
+func (s *session) play() error {
+	s.g.AddListener(mp1.ListenerFunc(func(g *mp1.Game, e mp1.GameEvent) {
+		if _, ok := e.(mp1.TurnEnded); ok {
+			s.standings()
+		}
+	}))
+	for s.g.NextEvent != nil {
+		r, err := s.ask()
+		if err == errQuit || err == io.EOF {
+			fmt.Fprintln(s.out, "Goodbye!")
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := s.g.Apply(r); err != nil {
+			fmt.Fprintln(s.out, err)
+		}
+	}
+	var names []string
+	for _, w := range s.g.Winners() {
+		names = append(names, s.g.Players[w].Char)
+	}
+	fmt.Fprintf(s.out, "Game over! Winner: %s\n", strings.Join(names, ", "))
+	return nil
+}
+
+//standings prints the turn and every player's stars and coins.
+func (s *session) standings() {
+	fmt.Fprintf(s.out, "--- Turn %d/%d ---\n", s.g.Turn, s.g.Config.MaxTurns)
+	for _, p := range s.g.Players {
+		fmt.Fprintf(s.out, "%s: %d Stars -- %d Coins\n",
+			p.Char, p.Stars, p.Coins)
+	}
+}
+
+//ask prints the next event's question and reads responses until one can
+//be parsed. Commands are handled along the way. An event without any
+//response is an error.
+func (s *session) ask() (mp1.Response, error) {
+	e := s.g.NextEvent
+	res := e.Responses()
+	if len(res) == 0 {
+		return nil, fmt.Errorf("%T has no responses", e)
+	}
+	fmt.Fprintln(s.out, e.Question(s.g))
+	switch e.Type() {
+	case mp1.RANGE_EVT_TYPE, mp1.COIN_EVT_TYPE:
+		fmt.Fprintf(s.out, "  Enter a number from %v to %v\n",
+			res[0], res[len(res)-1])
+	case mp1.MULTIWIN_PLAYER_EVT_TYPE:
+		fmt.Fprintln(s.out, "  Enter the winning players (e.g. 13), "+
+			"a bitmask (e.g. 0b0101) or none")
+	default:
+		for i, r := range res {
+			fmt.Fprintf(s.out, "  %d) %s\n", i+1, s.label(e, r))
+		}
+	}
+	for {
+		line, err := s.readLine("> ")
+		if err != nil {
+			return nil, err
+		}
+		if handled, err := s.command(line); handled {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		r, err := parseResponse(e, res, line)
+		if err != nil {
+			fmt.Fprintln(s.out, err)
+			continue
+		}
+		return r, nil
+	}
+}
+
+//label describes the response r of e.
+func (s *session) label(e mp1.Event, r mp1.Response) string {
+	switch e.Type() {
+	case mp1.PLAYER_EVT_TYPE:
+		if p, ok := r.(int); ok && p < len(s.g.Players) {
+			return s.g.Players[p].Char
+		} else if ok {
+			return "Draw"
+		}
+	case mp1.CHAINSPACE_EVT_TYPE:
+		if cs, ok := r.(mp1.ChainSpace); ok {
+			return fmt.Sprintf("Chain %d, Space %d", cs.Chain, cs.Space)
+		}
+	}
+	return fmt.Sprint(r)
+}
+
+//command handles line if it is a command. It returns true if it was.
+func (s *session) command(line string) (bool, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return false, nil
+	}
+	switch fields[0] {
+	case "quit":
+		return true, errQuit
+	case "standings":
+		s.standings()
+		return true, nil
+	case "save":
+		if len(fields) != 2 {
+			fmt.Fprintln(s.out, "Usage: save FILE")
+			return true, nil
+		}
+		if err := saveFile(fields[1], s.g); err != nil {
+			fmt.Fprintln(s.out, err)
+		} else {
+			fmt.Fprintf(s.out, "Saved to %s\n", fields[1])
+		}
+		return true, nil
+	case "help":
+		fmt.Fprintln(s.out, "Commands: save FILE, standings, quit")
+		return true, nil
+	}
+	return false, nil
+}
+
+//parseResponse parses line as a response of e. res are e's responses.
+func parseResponse(e mp1.Event, res []mp1.Response, line string) (mp1.Response, error) {
+	switch e.Type() {
+	case mp1.RANGE_EVT_TYPE, mp1.COIN_EVT_TYPE:
+		i, err := strconv.Atoi(line)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", line)
+		}
+		return i, nil
+	case mp1.MULTIWIN_PLAYER_EVT_TYPE:
+		return parsePlayerMask(line)
+	}
+	i, err := strconv.Atoi(line)
+	if err != nil || i < 1 || i > len(res) {
+		return nil, fmt.Errorf("pick a response from 1 to %d", len(res))
+	}
+	return res[i-1], nil
+}
+
+//parsePlayerMask parses a list of player numbers, a 0b bitmask or none
+//into a player mask.
+func parsePlayerMask(line string) (int, error) {
+	if line == "none" {
+		return 0, nil
+	}
+	if strings.HasPrefix(line, "0b") {
+		mask, err := strconv.ParseInt(line[2:], 2, 0)
+		if err != nil || mask > 0xF {
+			return 0, fmt.Errorf("%q is not a 4 bit mask", line)
+		}
+		return int(mask), nil
+	}
+	mask := 0
+	for _, c := range line {
+		if c < '1' || c > '4' {
+			return 0, fmt.Errorf("%q is not a list of players 1 to 4", line)
+		}
+		mask |= 1 << uint(c-'1')
+	}
+	if mask == 0 {
+		return 0, fmt.Errorf("enter the winning players or none")
+	}
+	return mask, nil
+}
+
+//saveFile saves g to the file at path.
+func saveFile(path string, g *mp1.Game) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := mp1.SaveGame(f, g); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+//loadFile loads the game saved in the file at path.
+func loadFile(path string) (*mp1.Game, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return mp1.LoadGame(f)
+}
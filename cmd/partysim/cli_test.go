@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+func TestParsePlayerMask(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected int
+		valid    bool
+	}{
+		{"13", 0b0101, true},
+		{"4", 0b1000, true},
+		{"0b1010", 0b1010, true},
+		{"none", 0, true},
+		{"5", 0, false},
+		{"0b10000", 0, false},
+		{"", 0, false},
+	}
+	for _, test := range tests {
+		got, err := parsePlayerMask(test.line)
+		if (err == nil) != test.valid || got != test.expected {
+			t.Errorf("Expected %q to parse to %d (valid: %v), got: %d, %v",
+				test.line, test.expected, test.valid, got, err)
+		}
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	dice := mp1.NormalDiceBlock{Range: mp1.Range{Min: 1, Max: 10}}
+	if r, err := parseResponse(dice, dice.Responses(), "7"); err != nil || r != 7 {
+		t.Errorf("Expected 7, got: %v, %v", r, err)
+	}
+	hidden := mp1.HiddenBlockEvent{}
+	res := hidden.Responses()
+	if r, err := parseResponse(hidden, res, "2"); err != nil || r != res[1] {
+		t.Errorf("Expected %v, got: %v, %v", res[1], r, err)
+	}
+	if _, err := parseResponse(hidden, res, "3"); err == nil {
+		t.Errorf("Expected out of range error")
+	}
+}
+
+func TestAskNoResponses(t *testing.T) {
+	var out bytes.Buffer
+	s := newSession(strings.NewReader("1\n"), &out)
+	b, _ := mp1.LookupBoard("MRC")
+	s.g = mp1.InitializeGame(b, mp1.GameConfig{MaxTurns: 20})
+	s.g.NextEvent = mp1.NormalDiceBlock{Range: mp1.Range{Min: 1, Max: 0}}
+	if _, err := s.ask(); err == nil {
+		t.Errorf("Expected an error for an event without responses")
+	}
+}
+
+func TestRunFullGame(t *testing.T) {
+	in := "Mario,Luigi,Peach,Yoshi\n" + strings.Repeat("1\n", 1000)
+	var out bytes.Buffer
+	err := run([]string{"-board", "mrc", "-turns", "2"},
+		strings.NewReader(in), &out)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	for _, s := range []string{"--- Turn 1/2 ---", "--- Turn 2/2 ---", "Game over!"} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("Expected output to contain %q", s)
+		}
+	}
+}
+
+func TestRunStats(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "timeline.csv")
+	in := strings.Repeat("1\n", 1000)
+	var out bytes.Buffer
+	err := run([]string{"-board", "mrc", "-turns", "2", "-stats", path,
+		"-chars", "Mario,Luigi,Peach,Yoshi"}, strings.NewReader(in), &out)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	//A header and 4 players at the start and after each turn
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 13 || !strings.HasPrefix(lines[12], "2,3,Yoshi,") {
+		t.Errorf("Expected the timeline of 2 turns, got:\n%s", data)
+	}
+}
+
+func TestRunSaveResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "game.json")
+	in := "5\n\n\n\n\n4\nsave " + path + "\nquit\n"
+	var out bytes.Buffer
+	if err := run(nil, strings.NewReader(in), &out); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	g, err := loadFile(path)
+	if err != nil {
+		t.Fatalf("Expected no load error, got: %v", err)
+	}
+	if name, _ := mp1.BoardName(g.Board); name != "MRC" {
+		t.Errorf("Expected MRC, got: %s", name)
+	}
+	if g.Players[0].Char != "Player 1" {
+		t.Errorf("Expected default character name, got: %s", g.Players[0].Char)
+	}
+
+	out.Reset()
+	err = run([]string{"-load", path}, strings.NewReader("quit\n"), &out)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(out.String(), g.NextEvent.Question(g)) {
+		t.Errorf("Expected resumed question, got: %s", out.String())
+	}
+}
@@ -0,0 +1,126 @@
+//Command partysim walks through a game of Mario Party 1, asking every
+//event's question and listing its responses.
+//
+//Usage:
+//
+//	partysim [flags]
+//
+//While playing, enter the number of a response, or one of the commands
+//save FILE, standings or quit. A saved game is resumed with -load FILE.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	_ "github.com/0xhexnumbers/partysim/mp1/board"
+	"github.com/0xhexnumbers/partysim/mp1/stats"
+)
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout)
+	if err != nil && err != io.EOF && err != flag.ErrHelp {
+		fmt.Fprintln(os.Stderr, "partysim:", err)
+		os.Exit(1)
+	}
+}
+
+//run parses args, sets up or loads the game and plays it.
+func run(args []string, in io.Reader, out io.Writer) error {
+	fs := flag.NewFlagSet("partysim", flag.ContinueOnError)
+	fs.SetOutput(out)
+	boardName := fs.String("board", "", "board to play: "+
+		strings.Join(mp1.BoardNames(), ", "))
+	chars := fs.String("chars", "", "comma separated character names")
+	load := fs.String("load", "", "resume the game saved in this file")
+	turns := fs.Uint("turns", 20, "number of turns")
+	noBonus := fs.Bool("no-bonus", false, "disable bonus stars")
+	noKoopa := fs.Bool("no-koopa", false, "disable Koopa's coins at start")
+	noBoo := fs.Bool("no-boo", false, "disable Boo")
+	red := fs.Bool("red", false, "enable red dice blocks")
+	blue := fs.Bool("blue", false, "enable blue dice blocks")
+	warp := fs.Bool("warp", false, "enable warp dice blocks")
+	events := fs.Bool("events", false, "enable event dice blocks")
+	timeline := fs.String("stats", "", "write the game's timeline to this "+
+		"file, as JSON Lines if it ends in .jsonl and CSV otherwise")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	s := newSession(in, out)
+	if *load != "" {
+		g, err := loadFile(*load)
+		if err != nil {
+			return err
+		}
+		s.g = g
+		s.standings()
+		return s.playRecording(*timeline)
+	}
+
+	if *turns == 0 || *turns > 255 {
+		return fmt.Errorf("turns must be between 1 and 255, got %d", *turns)
+	}
+	var b mp1.Board
+	if *boardName != "" {
+		var ok bool
+		b, ok = mp1.LookupBoard(strings.ToUpper(*boardName))
+		if !ok {
+			return fmt.Errorf("unknown board %q", *boardName)
+		}
+	} else {
+		var err error
+		if b, err = s.pickBoard(); err != nil {
+			return err
+		}
+	}
+	s.g = mp1.InitializeGame(b, mp1.GameConfig{
+		MaxTurns:     uint8(*turns),
+		NoBonusStars: *noBonus,
+		NoKoopa:      *noKoopa,
+		NoBoo:        *noBoo,
+		RedDice:      *red,
+		BlueDice:     *blue,
+		WarpDice:     *warp,
+		EventsDice:   *events,
+	})
+	var names []string
+	if *chars != "" {
+		names = strings.Split(*chars, ",")
+	}
+	if err := s.pickChars(names); err != nil {
+		return err
+	}
+	return s.playRecording(*timeline)
+}
+
+//playRecording plays the game, then writes its timeline to the file at
+//path, even if the game was quit early. Nothing is recorded if path is
+//empty.
+func (s *session) playRecording(path string) error {
+	if path == "" {
+		return s.play()
+	}
+	r := stats.NewRecorder(s.g)
+	err := s.play()
+	f, ferr := os.Create(path)
+	if ferr != nil {
+		return ferr
+	}
+	if strings.HasSuffix(path, ".jsonl") {
+		ferr = r.WriteJSONL(f)
+	} else {
+		ferr = r.WriteCSV(f)
+	}
+	if cerr := f.Close(); ferr == nil {
+		ferr = cerr
+	}
+	if ferr != nil {
+		return ferr
+	}
+	return err
+}
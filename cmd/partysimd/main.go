@@ -0,0 +1,23 @@
+//Command partysimd serves the httpapi game session API for every MP1
+//board.
+//
+//Usage:
+//
+//	partysimd [-addr :8080]
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	_ "github.com/0xhexnumbers/partysim/mp1/board"
+	"github.com/0xhexnumbers/partysim/mp1/httpapi"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	flag.Parse()
+	log.Printf("partysimd: listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, httpapi.NewServer()))
+}
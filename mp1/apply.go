@@ -0,0 +1,83 @@
+package mp1
+
+import (
+	"errors"
+	"fmt"
+)
+
+//ErrNoPendingEvent is returned by Apply when the game has no next event,
+//which means the game is over.
+var ErrNoPendingEvent = errors.New("mp1: no pending event")
+
+//ErrInvalidResponse is wrapped by every InvalidResponseError.
+var ErrInvalidResponse = errors.New("mp1: invalid response")
+
+//InvalidResponseError is returned by Apply when Response is not one of
+//the responses Event accepts.
+type InvalidResponseError struct {
+	Event    Event
+	Response Response
+}
+
+func (e *InvalidResponseError) Error() string {
+	return fmt.Sprintf("mp1: invalid response %#v for %T", e.Response, e.Event)
+}
+
+func (e *InvalidResponseError) Unwrap() error {
+	return ErrInvalidResponse
+}
+
+//Apply is a checked version of HandleEvent. It validates r against the
+//next event before handling it. If r is not valid, or the event panics
+//while being handled, the game is left untouched and an error is
+//returned.
+func (g *Game) Apply(r Response) (err error) {
+	if g.NextEvent == nil {
+		return ErrNoPendingEvent
+	}
+	before := g.Clone()
+	defer func() {
+		if p := recover(); p != nil {
+			g.restore(before)
+			err = fmt.Errorf("mp1: %T panicked handling %#v: %v",
+				before.NextEvent, r, p)
+		}
+	}()
+	if !ValidResponse(g.NextEvent, r) {
+		return &InvalidResponseError{g.NextEvent, r}
+	}
+	g.HandleEvent(r)
+	return nil
+}
+
+//ValidResponse returns true if r is one of the responses e accepts.
+//Events built on a Range are checked against their bounds without
+//building the full response list.
+func ValidResponse(e Event, r Response) bool {
+	if rng, ok := e.(ranged); ok {
+		return rng.contains(r)
+	}
+	for i, n := 0, ResponseCount(e); i < n; i++ {
+		if ResponseAt(e, i) == r {
+			return true
+		}
+	}
+	return false
+}
+
+//ranged is implemented by every event that embeds a Range.
+type ranged interface {
+	contains(r Response) bool
+	bounds() Range
+}
+
+//bounds returns the Range embedded in an event.
+func (r Range) bounds() Range {
+	return r
+}
+
+//contains returns true if r is an int within [r.Min, r.Max].
+func (r Range) contains(res Response) bool {
+	i, ok := res.(int)
+	return ok && r.Min <= i && i <= r.Max
+}
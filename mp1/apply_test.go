@@ -0,0 +1,83 @@
+package mp1
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestApplyValidResponse(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	if err := g.Apply(4); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	SpaceIs(ChainSpace{0, 4}, 0, *g, "", t)
+}
+
+func TestApplyInvalidResponse(t *testing.T) {
+	g := InitializeGame(BowserBoard, GameConfig{MaxTurns: 20})
+	g.Players[0].Coins = 30
+	g.MovePlayer(0, 1)
+	before := g.Clone()
+
+	tests := []Response{
+		11,               //Out of range
+		"CoinsForBowser", //Wrong type
+		ChainSpace{0, 1}, //Wrong type
+		BowserResponse(99),
+	}
+	for _, r := range tests {
+		err := g.Apply(r)
+		if !errors.Is(err, ErrInvalidResponse) {
+			t.Errorf("Expected ErrInvalidResponse for %#v, got: %v", r, err)
+		}
+		var invalid *InvalidResponseError
+		if !errors.As(err, &invalid) || invalid.Response != r {
+			t.Errorf("Expected InvalidResponseError for %#v, got: %v", r, err)
+		}
+		if !reflect.DeepEqual(before, g) {
+			t.Fatalf("Expected game to be untouched by %#v", r)
+		}
+	}
+}
+
+func TestApplyRange(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	for _, r := range []Response{0, 11, -1} {
+		if err := g.Apply(r); !errors.Is(err, ErrInvalidResponse) {
+			t.Errorf("Expected ErrInvalidResponse for %#v, got: %v", r, err)
+		}
+	}
+}
+
+func TestApplyUnknownStarSpace(t *testing.T) {
+	g := InitializeGame(multipleStarBoard, GameConfig{MaxTurns: 20})
+	err := g.Apply(ChainSpace{0, 0})
+	if !errors.Is(err, ErrInvalidResponse) {
+		t.Errorf("Expected ErrInvalidResponse, got: %v", err)
+	}
+}
+
+func TestApplyNoPendingEvent(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	g.NextEvent = nil
+	if err := g.Apply(1); err != ErrNoPendingEvent {
+		t.Errorf("Expected ErrNoPendingEvent, got: %v", err)
+	}
+}
+
+type panickingEvent struct{ NormalDiceBlock }
+
+func (p panickingEvent) Handle(r Response, g *Game) {
+	g.Players[0].Coins = 100
+	panic("bad event")
+}
+
+func TestApplyRestoresAfterPanic(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	g.NextEvent = panickingEvent{NormalDiceBlock{Range{1, 10}, 0}}
+	if err := g.Apply(1); err == nil {
+		t.Fatalf("Expected error from panicking event")
+	}
+	CoinsIs(10, 0, *g, "", t)
+}
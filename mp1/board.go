@@ -18,6 +18,36 @@ const (
 	Boo
 )
 
+func (s SpaceType) String() string {
+	switch s {
+	case Invisible:
+		return "Invisible"
+	case Blue:
+		return "Blue"
+	case Red:
+		return "Red"
+	case MinigameSpace:
+		return "Minigame"
+	case Happening:
+		return "Happening"
+	case Star:
+		return "Star"
+	case Chance:
+		return "Chance"
+	case Start:
+		return "Start"
+	case Mushroom:
+		return "Mushroom"
+	case Bowser:
+		return "Bowser"
+	case BogusItem:
+		return "Bogus Item"
+	case Boo:
+		return "Boo"
+	}
+	return ""
+}
+
 //Space is a physical space on the board that Players can land on and/or
 //pass by.
 type Space struct {
@@ -35,6 +65,28 @@ type Space struct {
 	//this space. Value returned is the number of moves the simulation
 	//needs to process before ending the Player's movement.
 	PassingEvent func(game *Game, player, moves int) int
+
+	//Exits lists the ChainSpaces this space's events can move a player
+	//to. The engine never reads it; it lets tools that walk the board,
+	//such as ValidateBoard, follow moves hidden inside the events. A
+	//player passing a space with a PassingEvent and Exits leaves through
+	//one of the Exits, where an Exit to the space itself means they keep
+	//walking down the chain. On other spaces, Exits are where the
+	//StoppingEvent can send the player.
+	Exits []Exit
+}
+
+//Exit is a ChainSpace a space's events can move a player to. The player
+//carries on from Dest as if they had just walked onto it.
+type Exit struct {
+	Dest ChainSpace
+
+	//Moves is the number of moves taking the exit uses up.
+	Moves int
+
+	//Coins is the least amount of coins a player needs to take the
+	//exit, as of the board's initial state.
+	Coins int
 }
 
 //Chain is a sequence of non-branching Spaces
@@ -55,6 +107,13 @@ func NewChainSpace(chain, space int) ChainSpace {
 //specific function calls may manipulate this data.
 type ExtraBoardData interface{}
 
+//ExtraBoardDataCloner is implemented by ExtraBoardData that holds
+//reference types (pointers, slices, maps). Game.Clone calls CloneData to
+//get a copy that shares no mutable memory with the original.
+type ExtraBoardDataCloner interface {
+	CloneData() ExtraBoardData
+}
+
 //EndCharacterTurnEvent is used anytime a player's turn is over.
 type EndCharacterTurnEvent interface {
 	EndCharacterTurn(game *Game, player int)
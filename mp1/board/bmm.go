@@ -104,9 +104,16 @@ var BMM = mp1.Board{
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
 			{Type: mp1.Star},
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
-			{Type: mp1.Invisible, PassingEvent: bmmReachFork(
-				mp1.NewChainSpace(1, 0), mp1.NewChainSpace(2, 2),
-			)},
+			{
+				Type: mp1.Invisible,
+				PassingEvent: bmmReachFork(
+					mp1.NewChainSpace(1, 0), mp1.NewChainSpace(2, 2),
+				),
+				Exits: []mp1.Exit{
+					{Dest: mp1.NewChainSpace(1, 0), Moves: 1},
+					{Dest: mp1.NewChainSpace(2, 2), Moves: 1, Coins: 10},
+				},
+			},
 		},
 		{ //Fork 1: Bowser Path to Fork 2
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
@@ -119,9 +126,16 @@ var BMM = mp1.Board{
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
-			{Type: mp1.Invisible, PassingEvent: bmmReachFork(
-				mp1.NewChainSpace(2, 0), mp1.NewChainSpace(3, 5),
-			)},
+			{
+				Type: mp1.Invisible,
+				PassingEvent: bmmReachFork(
+					mp1.NewChainSpace(2, 0), mp1.NewChainSpace(3, 5),
+				),
+				Exits: []mp1.Exit{
+					{Dest: mp1.NewChainSpace(2, 0), Moves: 1},
+					{Dest: mp1.NewChainSpace(3, 5), Moves: 1, Coins: 10},
+				},
+			},
 		},
 		{ //Fork 2: Bowser Path to Fork 3
 			{Type: mp1.Mushroom},
@@ -135,9 +149,16 @@ var BMM = mp1.Board{
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
-			{Type: mp1.Invisible, PassingEvent: bmmReachFork(
-				mp1.NewChainSpace(3, 0), mp1.NewChainSpace(0, 1),
-			)},
+			{
+				Type: mp1.Invisible,
+				PassingEvent: bmmReachFork(
+					mp1.NewChainSpace(3, 0), mp1.NewChainSpace(0, 1),
+				),
+				Exits: []mp1.Exit{
+					{Dest: mp1.NewChainSpace(3, 0), Moves: 1},
+					{Dest: mp1.NewChainSpace(0, 1), Moves: 1, Coins: 10},
+				},
+			},
 		},
 		{ //Fork 3: BowserPath to Fork 4
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
@@ -153,7 +174,13 @@ var BMM = mp1.Board{
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
-			{Type: mp1.Invisible, PassingEvent: bmmFinalFork},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: bmmFinalFork,
+				Exits: exits(1,
+					mp1.NewChainSpace(4, 0), mp1.NewChainSpace(5, 0),
+				),
+			},
 		},
 		{ //Fork 4: Bowser Path
 			{Type: mp1.Invisible, StoppingEvent: bmmLandOnRegularSpace, HiddenBlock: true},
@@ -23,6 +23,16 @@ func (b BMMBranchPayResponse) String() string {
 	return ""
 }
 
+func (b BMMBranchPayResponse) Key() string {
+	switch b {
+	case BMMBranchPayPay:
+		return "BMMBranchPayPay"
+	case BMMBranchPayIgnore:
+		return "BMMBranchPayIgnore"
+	}
+	return ""
+}
+
 //BMMBranchPay is a custom branch event for the player to decide if they
 //want to pay 10 coins to take a chance at taking the star path.
 type BMMBranchPay struct {
@@ -41,8 +51,18 @@ func (b BMMBranchPay) Type() mp1.EventType {
 	return mp1.ENUM_EVT_TYPE
 }
 
+var bmmBranchPayResponses = []mp1.Response{BMMBranchPayPay, BMMBranchPayIgnore}
+
 func (b BMMBranchPay) Responses() []mp1.Response {
-	return []mp1.Response{BMMBranchPayPay, BMMBranchPayIgnore}
+	return append([]mp1.Response(nil), bmmBranchPayResponses...)
+}
+
+func (b BMMBranchPay) ResponseCount() int {
+	return len(bmmBranchPayResponses)
+}
+
+func (b BMMBranchPay) ResponseAt(i int) mp1.Response {
+	return bmmBranchPayResponses[i]
 }
 
 func (b BMMBranchPay) ControllingPlayer() int {
@@ -87,6 +107,14 @@ func (b BMMBranchDecision) Responses() []mp1.Response {
 	return []mp1.Response{b.BowserPath, b.StarPath}
 }
 
+func (b BMMBranchDecision) ResponseCount() int {
+	return 2
+}
+
+func (b BMMBranchDecision) ResponseAt(i int) mp1.Response {
+	return [2]mp1.ChainSpace{b.BowserPath, b.StarPath}[i]
+}
+
 func (b BMMBranchDecision) ControllingPlayer() int {
 	return mp1.CPU_PLAYER
 }
@@ -115,6 +143,16 @@ func (b BMMBowserRouletteResponse) String() string {
 	return ""
 }
 
+func (b BMMBowserRouletteResponse) Key() string {
+	switch b {
+	case BMMBowserRoulette20Coins:
+		return "BMMBowserRoulette20Coins"
+	case BMMBowserRouletteStar:
+		return "BMMBowserRouletteStar"
+	}
+	return ""
+}
+
 //BMMBowserRoulette decides if bowser steals a star or 20 coins.
 type BMMBowserRoulette struct {
 	Player int
@@ -130,8 +168,21 @@ func (b BMMBowserRoulette) Type() mp1.EventType {
 	return mp1.ENUM_EVT_TYPE
 }
 
+var bmmBowserRouletteResponses = []mp1.Response{
+	BMMBowserRoulette20Coins,
+	BMMBowserRouletteStar,
+}
+
 func (b BMMBowserRoulette) Responses() []mp1.Response {
-	return []mp1.Response{BMMBowserRoulette20Coins, BMMBowserRouletteStar}
+	return append([]mp1.Response(nil), bmmBowserRouletteResponses...)
+}
+
+func (b BMMBowserRoulette) ResponseCount() int {
+	return len(bmmBowserRouletteResponses)
+}
+
+func (b BMMBowserRoulette) ResponseAt(i int) mp1.Response {
+	return bmmBowserRouletteResponses[i]
 }
 
 func (b BMMBowserRoulette) ControllingPlayer() int {
@@ -143,7 +194,7 @@ func (b BMMBowserRoulette) ControllingPlayer() int {
 func (b BMMBowserRoulette) Handle(r mp1.Response, g *mp1.Game) {
 	starSteal := r.(BMMBowserRouletteResponse)
 	if starSteal == BMMBowserRouletteStar {
-		g.Players[b.Player].Stars--
+		g.AwardStars(b.Player, -1)
 	} else {
 		g.AwardCoins(b.Player, -20, false)
 	}
@@ -0,0 +1,53 @@
+package board
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//TestCloneIsolation plays random games on every board. Before every event,
+//the game is cloned and the clone is played to completion. The original
+//must be byte-for-byte identical afterwards.
+func TestCloneIsolation(t *testing.T) {
+	config := mp1.GameConfig{
+		MaxTurns:   10,
+		RedDice:    true,
+		BlueDice:   true,
+		WarpDice:   true,
+		EventsDice: true,
+	}
+	for i, name := range allBoards {
+		b, _ := mp1.LookupBoard(name)
+		r := rand.New(rand.NewSource(int64(i)))
+		g := mp1.InitializeGame(b, config)
+		for step := 0; g.NextEvent != nil; step++ {
+			before, err := json.Marshal(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if step%5 == 0 {
+				c := g.Clone()
+				for c.NextEvent != nil {
+					res := c.NextEvent.Responses()
+					c.HandleEvent(res[r.Intn(len(res))])
+				}
+			}
+
+			after, err := json.Marshal(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(before) != string(after) {
+				t.Fatalf("%s step %d: Expected game:\n%s\ngot:\n%s",
+					name, step, before, after)
+			}
+
+			res := g.NextEvent.Responses()
+			g.HandleEvent(res[r.Intn(len(res))])
+		}
+	}
+}
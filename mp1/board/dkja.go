@@ -74,6 +74,10 @@ func dkjaBoulder(g *mp1.Game, player int) {
 	}
 }
 
+//dkjaBoulderExits are the Exits of the boulder spaces on the boulder's
+//path, which send the landing player to the end of the path as well.
+var dkjaBoulderExits = exits(0, mp1.NewChainSpace(0, 16))
+
 //DKJA holds the data for Donkey Kong's Jungle Adventure.
 var DKJA = mp1.Board{
 	Chains: &[]mp1.Chain{
@@ -106,14 +110,27 @@ var DKJA = mp1.Board{
 			{Type: mp1.Red},
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: dkjaCanPassWhomp(0)},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: dkjaCanPassWhomp(0),
+				Exits: exits(1,
+					mp1.NewChainSpace(4, 0), mp1.NewChainSpace(1, 0),
+				),
+			},
 		},
 		{ //First offshoot to coin blockade
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
 			{Type: mp1.Red},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: dkjaCanPassCoinBlockade(0)},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: dkjaCanPassCoinBlockade(0),
+				Exits: []mp1.Exit{
+					{Dest: mp1.NewChainSpace(2, 0), Moves: 1, Coins: 20},
+					{Dest: mp1.NewChainSpace(3, 0), Moves: 1},
+				},
+			},
 		},
 		{ //Through first coin blockade
 			{Type: mp1.Blue},
@@ -133,21 +150,34 @@ var DKJA = mp1.Board{
 		{ //First main pathway
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: dkjaCanPassWhomp(1)},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: dkjaCanPassWhomp(1),
+				Exits: exits(1,
+					mp1.NewChainSpace(5, 0), mp1.NewChainSpace(6, 0),
+				),
+			},
 		},
 		{ //Second main pathway
 			{Type: mp1.Blue},
 			{Type: mp1.MinigameSpace},
 			{Type: mp1.Blue},
-			{Type: mp1.Happening, StoppingEvent: dkjaBoulder},
-			{Type: mp1.Happening, StoppingEvent: dkjaBoulder},
+			{Type: mp1.Happening, StoppingEvent: dkjaBoulder, Exits: dkjaBoulderExits},
+			{Type: mp1.Happening, StoppingEvent: dkjaBoulder, Exits: dkjaBoulderExits},
 			{Type: mp1.Blue},
 			{Type: mp1.Star},
 			{Type: mp1.Mushroom},
 			{Type: mp1.Blue},
-			{Type: mp1.Happening, StoppingEvent: dkjaBoulder},
+			{Type: mp1.Happening, StoppingEvent: dkjaBoulder, Exits: dkjaBoulderExits},
 			{Type: mp1.Boo},
-			{Type: mp1.Invisible, PassingEvent: dkjaCanPassCoinBlockade(1)},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: dkjaCanPassCoinBlockade(1),
+				Exits: []mp1.Exit{
+					{Dest: mp1.NewChainSpace(7, 0), Moves: 1, Coins: 20},
+					{Dest: mp1.NewChainSpace(8, 0), Moves: 1},
+				},
+			},
 		},
 		{ //Second offshoot pathway
 			{Type: mp1.Blue},
@@ -166,8 +196,8 @@ var DKJA = mp1.Board{
 			{Type: mp1.BogusItem},
 			{Type: mp1.Red},
 			{Type: mp1.Blue},
-			{Type: mp1.Happening, StoppingEvent: dkjaBoulder},
-			{Type: mp1.Happening, StoppingEvent: dkjaBoulder},
+			{Type: mp1.Happening, StoppingEvent: dkjaBoulder, Exits: dkjaBoulderExits},
+			{Type: mp1.Happening, StoppingEvent: dkjaBoulder, Exits: dkjaBoulderExits},
 			{Type: mp1.Blue},
 			{Type: mp1.Star},
 			{Type: mp1.Blue},
@@ -181,7 +211,13 @@ var DKJA = mp1.Board{
 			{Type: mp1.Star},
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: dkjaCanPassWhomp(2)},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: dkjaCanPassWhomp(2),
+				Exits: exits(1,
+					mp1.NewChainSpace(9, 0), mp1.NewChainSpace(0, 0),
+				),
+			},
 		},
 		{ //Third Main Pathway
 			{Type: mp1.Red},
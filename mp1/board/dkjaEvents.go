@@ -23,6 +23,16 @@ func (d DKJAWhompResponse) String() string {
 	return ""
 }
 
+func (d DKJAWhompResponse) Key() string {
+	switch d {
+	case DKJAWhompPay:
+		return "DKJAWhompPay"
+	case DKJAWhompIgnore:
+		return "DKJAWhompIgnore"
+	}
+	return ""
+}
+
 //DKJAWhompEvent let's the player decide to go and pay the whomp 10 coins
 //or ignore the whomp.
 type DKJAWhompEvent struct {
@@ -44,8 +54,18 @@ func (d DKJAWhompEvent) ControllingPlayer() int {
 	return d.Player
 }
 
+var dkjaWhompResponses = []mp1.Response{DKJAWhompPay, DKJAWhompIgnore}
+
 func (d DKJAWhompEvent) Responses() []mp1.Response {
-	return []mp1.Response{DKJAWhompPay, DKJAWhompIgnore}
+	return append([]mp1.Response(nil), dkjaWhompResponses...)
+}
+
+func (d DKJAWhompEvent) ResponseCount() int {
+	return len(dkjaWhompResponses)
+}
+
+func (d DKJAWhompEvent) ResponseAt(i int) mp1.Response {
+	return dkjaWhompResponses[i]
 }
 
 //Handle moves the player to the appropriate space and takes coins away
@@ -23,7 +23,7 @@ var esEntrance9 = mp1.NewChainSpace(12, 0)
 //under.
 func esVisitBowser(g *mp1.Game, player, moves int) int {
 	if g.Players[player].Stars > 0 {
-		g.Players[player].Stars--
+		g.AwardStars(player, -1)
 	} else {
 		g.AwardCoins(player, -20, false)
 	}
@@ -42,13 +42,13 @@ func esSendToStart(g *mp1.Game, player int) {
 	//because the game thinks you landed on a blue space as well.
 	//Happening space, thankfully, still count towards happening
 	//star.
-	if g.LastFiveTurns() {
-		g.AwardCoins(player, 6, false)
-	} else {
-		g.AwardCoins(player, 3, false)
-	}
+	g.AwardCoins(player, g.BlueSpaceCoins(), false)
 }
 
+//esSendToStartExits are the Exits of the spaces that send players to the
+//starting space.
+var esSendToStartExits = exits(0, esStartingSpace)
+
 //esWarpC handles Warp C.
 func esWarpC(g *mp1.Game, player, moves int) int {
 	bd := g.Board.Data.(esBoardData)
@@ -116,15 +116,15 @@ func esAllStarsCollected(e esBoardData) bool {
 }
 
 //esPassStarSpace sets the next event to decide if the player wants to play
-//the baby bowser minigame if they have >=20 coins and the star space is
-//available. If the player does not have 20 coins, then they pass by the
+//the baby bowser minigame if they can pay the star price and the star space
+//is available. If the player does not have enough coins, they pass by the
 //space. If the star has been collected, then the space is assumed to be
 //landable.
 func esPassStarSpace(i int) func(*mp1.Game, int, int) int {
 	return func(g *mp1.Game, player, moves int) int {
 		bd := g.Board.Data.(esBoardData)
 		if !bd.StarTaken[i] {
-			if g.Players[player].Coins >= 20 {
+			if g.Players[player].Coins >= g.Economy().StarPrice {
 				g.NextEvent = ESVisitBabyBowser{
 					player,
 					moves,
@@ -167,7 +167,11 @@ var ES = mp1.Board{
 			{Type: mp1.Blue},
 			{Type: mp1.MinigameSpace},
 			{Type: mp1.Bowser},
-			{Type: mp1.Invisible, PassingEvent: esWarpC},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: esWarpC,
+				Exits:        exits(0, esStartingSpace, esEntrance7, esEntrance1),
+			},
 		},
 		{ //4: Entrance 2 Fork: Right Exit to warp D
 			{Type: mp1.Blue},
@@ -178,7 +182,7 @@ var ES = mp1.Board{
 				StoppingEvent: esLandOnChanceTime,
 			},
 			{Type: mp1.Blue},
-			{Type: mp1.Happening, StoppingEvent: esSendToStart},
+			{Type: mp1.Happening, StoppingEvent: esSendToStart, Exits: esSendToStartExits},
 			{
 				Type: mp1.Invisible,
 				PassingEvent: esWarpSpace(
@@ -186,6 +190,7 @@ var ES = mp1.Board{
 					esEntrance7,
 					esEntrance6,
 				),
+				Exits: exits(0, esEntrance1, esEntrance7, esEntrance6),
 			},
 		},
 		{ //5: Entrance 3 Fork: Right exit to warp E
@@ -205,11 +210,12 @@ var ES = mp1.Board{
 					esEntrance4,
 					esEntrance1,
 				),
+				Exits: exits(0, esEntrance6, esEntrance4, esEntrance1),
 			},
 		},
 		{ //6: Entrance 3 Fork: Left exit to warp F
 			{Type: mp1.Blue},
-			{Type: mp1.Happening, StoppingEvent: esSendToStart},
+			{Type: mp1.Happening, StoppingEvent: esSendToStart, Exits: esSendToStartExits},
 			{
 				Type: mp1.Invisible,
 				PassingEvent: esWarpSpace(
@@ -217,6 +223,7 @@ var ES = mp1.Board{
 					esEntrance6,
 					esEntrance7,
 				),
+				Exits: exits(0, esEntrance1, esEntrance6, esEntrance7),
 			},
 		},
 		{ //7: Entrance 4 to Warp H with Warp G branch
@@ -236,6 +243,7 @@ var ES = mp1.Board{
 					esEntrance9,
 					esEntrance8,
 				),
+				Exits: exits(0, mp1.NewChainSpace(7, 5), esEntrance9, esEntrance8),
 			},
 			{Type: mp1.Blue},
 			{Type: mp1.Mushroom},
@@ -253,12 +261,13 @@ var ES = mp1.Board{
 					esEntrance8,
 					esEntrance5,
 				),
+				Exits: exits(0, esEntrance5, esEntrance8),
 			},
 		},
 		{ //8: Entrance 5 to Warp I (always goes to start
 			{Type: mp1.Invisible}, //Movement
 			{Type: mp1.Red},
-			{Type: mp1.Happening, StoppingEvent: esSendToStart},
+			{Type: mp1.Happening, StoppingEvent: esSendToStart, Exits: esSendToStartExits},
 			{
 				Type:          mp1.Invisible,
 				PassingEvent:  esPassStarSpace(4),
@@ -288,7 +297,7 @@ var ES = mp1.Board{
 				PassingEvent:  esPassStarSpace(6),
 				StoppingEvent: esLandOnChanceTime,
 			},
-			{Type: mp1.Happening, StoppingEvent: esSendToStart},
+			{Type: mp1.Happening, StoppingEvent: esSendToStart, Exits: esSendToStartExits},
 			{ //Warp J
 				Type: mp1.Invisible,
 				PassingEvent: esBranchWithWarp(
@@ -296,6 +305,7 @@ var ES = mp1.Board{
 					esEntrance9,
 					esEntrance8,
 				),
+				Exits: exits(0, mp1.NewChainSpace(9, 10), esEntrance9, esEntrance8),
 			},
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
@@ -311,6 +321,7 @@ var ES = mp1.Board{
 					esEntrance1,
 					esEntrance4,
 				),
+				Exits: exits(0, esEntrance8, esEntrance1, esEntrance4),
 			},
 		},
 		{ //10: Entrance 7 to Entrance 6 convergence
@@ -323,8 +334,12 @@ var ES = mp1.Board{
 			{Type: mp1.Boo},
 		},
 		{ //12: Entrance 9
-			{Type: mp1.Invisible},                              //Tmp space for movement
-			{Type: mp1.Invisible, PassingEvent: esVisitBowser}, //Bowser
+			{Type: mp1.Invisible}, //Tmp space for movement
+			{ //Bowser
+				Type:         mp1.Invisible,
+				PassingEvent: esVisitBowser,
+				Exits:        exits(0, esEntrance1),
+			},
 		},
 	},
 	Links: &map[int]*[]mp1.ChainSpace{
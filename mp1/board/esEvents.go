@@ -23,6 +23,16 @@ func (e ESBranchResponse) String() string {
 	return ""
 }
 
+func (e ESBranchResponse) Key() string {
+	switch e {
+	case ESBranchGotoWarp:
+		return "ESBranchGotoWarp"
+	case ESBranchContinue:
+		return "ESBranchContinue"
+	}
+	return ""
+}
+
 //ESBranchEvent let's the player decide if they want to take the warp.
 type ESBranchEvent struct {
 	Player int
@@ -45,8 +55,18 @@ func (e ESBranchEvent) ControllingPlayer() int {
 	return e.Player
 }
 
+var esBranchResponses = []mp1.Response{ESBranchGotoWarp, ESBranchContinue}
+
 func (e ESBranchEvent) Responses() []mp1.Response {
-	return []mp1.Response{ESBranchGotoWarp, ESBranchContinue}
+	return append([]mp1.Response(nil), esBranchResponses...)
+}
+
+func (e ESBranchEvent) ResponseCount() int {
+	return len(esBranchResponses)
+}
+
+func (e ESBranchEvent) ResponseAt(i int) mp1.Response {
+	return esBranchResponses[i]
 }
 
 //Handle executes based on r. If r is true, the player's new position is set
@@ -91,13 +111,23 @@ const (
 func (e ESVisitBabyBowserResponse) String() string {
 	switch e {
 	case ESVisitBabyBowserPlay:
-		return "Pay 20 coins to play minigame"
+		return "Pay to play minigame"
 	case ESVisitBabyBowserIgnore:
 		return "Do not play minigame"
 	}
 	return ""
 }
 
+func (e ESVisitBabyBowserResponse) Key() string {
+	switch e {
+	case ESVisitBabyBowserPlay:
+		return "ESVisitBabyBowserPlay"
+	case ESVisitBabyBowserIgnore:
+		return "ESVisitBabyBowserIgnore"
+	}
+	return ""
+}
+
 //ESVisitBabyBowser let's the player decide if they want to play baby
 //bowser's minigame to win a star.
 type ESVisitBabyBowser struct {
@@ -108,8 +138,8 @@ type ESVisitBabyBowser struct {
 
 func (e ESVisitBabyBowser) Question(g *mp1.Game) string {
 	return fmt.Sprintf(
-		"Does %s pay 20 coins to play Baby Bowser's star minigame?",
-		g.Players[e.Player].Char,
+		"Does %s pay %d coins to play Baby Bowser's star minigame?",
+		g.Players[e.Player].Char, g.Economy().StarPrice,
 	)
 }
 
@@ -121,8 +151,21 @@ func (e ESVisitBabyBowser) ControllingPlayer() int {
 	return e.Player
 }
 
+var esVisitBabyBowserResponses = []mp1.Response{
+	ESVisitBabyBowserPlay,
+	ESVisitBabyBowserIgnore,
+}
+
 func (e ESVisitBabyBowser) Responses() []mp1.Response {
-	return []mp1.Response{ESVisitBabyBowserPlay, ESVisitBabyBowserIgnore}
+	return append([]mp1.Response(nil), esVisitBabyBowserResponses...)
+}
+
+func (e ESVisitBabyBowser) ResponseCount() int {
+	return len(esVisitBabyBowserResponses)
+}
+
+func (e ESVisitBabyBowser) ResponseAt(i int) mp1.Response {
+	return esVisitBabyBowserResponses[i]
 }
 
 //Handle sets the next event to the baby bowser minigame if r is true. If r
@@ -130,7 +173,7 @@ func (e ESVisitBabyBowser) Responses() []mp1.Response {
 func (e ESVisitBabyBowser) Handle(r mp1.Response, g *mp1.Game) {
 	battle := r.(ESVisitBabyBowserResponse)
 	if battle == ESVisitBabyBowserPlay {
-		g.AwardCoins(e.Player, -20, false)
+		g.AwardCoins(e.Player, -g.Economy().StarPrice, false)
 		g.NextEvent = ESBattleBabyBowser{
 			e.Player, e.Moves, e.Index,
 		}
@@ -156,6 +199,16 @@ func (e ESBattleBabyBowserResponse) String() string {
 	return ""
 }
 
+func (e ESBattleBabyBowserResponse) Key() string {
+	switch e {
+	case ESBattleBabyBowserWin:
+		return "ESBattleBabyBowserWin"
+	case ESBattleBabyBowserLose:
+		return "ESBattleBabyBowserLose"
+	}
+	return ""
+}
+
 //ESBattleBabyBowser decides if the player wins the minigame.
 type ESBattleBabyBowser struct {
 	Player int
@@ -176,8 +229,21 @@ func (e ESBattleBabyBowser) ControllingPlayer() int {
 	return mp1.CPU_PLAYER
 }
 
+var esBattleBabyBowserResponses = []mp1.Response{
+	ESBattleBabyBowserWin,
+	ESBattleBabyBowserLose,
+}
+
 func (e ESBattleBabyBowser) Responses() []mp1.Response {
-	return []mp1.Response{ESBattleBabyBowserWin, ESBattleBabyBowserLose}
+	return append([]mp1.Response(nil), esBattleBabyBowserResponses...)
+}
+
+func (e ESBattleBabyBowser) ResponseCount() int {
+	return len(esBattleBabyBowserResponses)
+}
+
+func (e ESBattleBabyBowser) ResponseAt(i int) mp1.Response {
+	return esBattleBabyBowserResponses[i]
 }
 
 //Handle gives the player a star and sets the baby bowser's StarTaken flag
@@ -186,7 +252,7 @@ func (e ESBattleBabyBowser) Handle(r mp1.Response, g *mp1.Game) {
 	star := r.(ESBattleBabyBowserResponse)
 	bd := g.Board.Data.(esBoardData)
 	if star == ESBattleBabyBowserWin {
-		g.Players[e.Player].Stars++
+		g.AwardStars(e.Player, 1)
 		bd.StarTaken[e.Index] = true
 		if esAllStarsCollected(bd) {
 			bd.StarTaken = [7]bool{
@@ -195,7 +261,7 @@ func (e ESBattleBabyBowser) Handle(r mp1.Response, g *mp1.Game) {
 		}
 		g.Board.Data = bd
 	} else if g.Players[e.Player].Stars > 0 {
-		g.Players[e.Player].Stars--
+		g.AwardStars(e.Player, -1)
 	}
 	g.MovePlayer(e.Player, e.Moves)
 }
@@ -214,10 +280,20 @@ func (e ESWarpCDest) Type() mp1.EventType {
 	return mp1.CHAINSPACE_EVT_TYPE
 }
 
+var esWarpCDestinations = []mp1.Response{esEntrance1, esEntrance7}
+
 //Resopnses returns a slice of the 2 possible spaces the player can warp
 //to.
 func (e ESWarpCDest) Responses() []mp1.Response {
-	return []mp1.Response{esEntrance1, esEntrance7}
+	return append([]mp1.Response(nil), esWarpCDestinations...)
+}
+
+func (e ESWarpCDest) ResponseCount() int {
+	return len(esWarpCDestinations)
+}
+
+func (e ESWarpCDest) ResponseAt(i int) mp1.Response {
+	return esWarpCDestinations[i]
 }
 
 func (e ESWarpCDest) ControllingPlayer() int {
@@ -273,6 +349,20 @@ func (e ESWarpDest) Responses() []mp1.Response {
 	return ret
 }
 
+func (e ESWarpDest) ResponseCount() int {
+	if e.Gate2or3 {
+		return 2
+	}
+	return 3
+}
+
+func (e ESWarpDest) ResponseAt(i int) mp1.Response {
+	if e.Gate2or3 {
+		i++
+	}
+	return [3]mp1.ChainSpace{e.Island1, e.Island2, e.Island3}[i]
+}
+
 func (e ESWarpDest) ControllingPlayer() int {
 	return mp1.CPU_PLAYER
 }
@@ -310,7 +400,10 @@ func (g Gate) String() string {
 	return fmt.Sprintf("Gate %d", int(g))
 }
 
-var esChangeGatesResponses = [3][]mp1.Response{
+//esChangeGatesResponses holds the gates that can be switched to from each
+//gate, starting with the unknown gate 0.
+var esChangeGatesResponses = [4][]mp1.Response{
+	{Gate(1), Gate(2), Gate(3)},
 	{Gate(2), Gate(3)},
 	{Gate(1), Gate(3)},
 	{Gate(1), Gate(2)},
@@ -324,9 +417,19 @@ func (e ESChangeGates) Type() mp1.EventType {
 	return mp1.ENUM_EVT_TYPE
 }
 
-//Responses returns the gates that can be switched to.
+//Responses returns the gates that can be switched to. If the current gate
+//is unknown, every gate can be.
 func (e ESChangeGates) Responses() []mp1.Response {
-	return esChangeGatesResponses[e.Current-1]
+	res := esChangeGatesResponses[e.Current]
+	return append([]mp1.Response(nil), res...)
+}
+
+func (e ESChangeGates) ResponseCount() int {
+	return len(esChangeGatesResponses[e.Current])
+}
+
+func (e ESChangeGates) ResponseAt(i int) mp1.Response {
+	return esChangeGatesResponses[e.Current][i]
 }
 
 func (e ESChangeGates) ControllingPlayer() int {
@@ -300,7 +300,9 @@ func TestVisitBowser(t *testing.T) {
 	g.Players[0].Coins = 30
 
 	gCoins := g
-	gCoins.NextEvent.Handle(1, &gCoins)       //Move
+	gCoins.NextEvent.Handle(1, &gCoins) //Move
+	//The gate is still unknown, so Bowser can set any of them
+	ResIs([]mp1.Response{Gate(1), Gate(2), Gate(3)}, gCoins, "Unknown Gate", t)
 	gCoins.NextEvent.Handle(Gate(1), &gCoins) //Set to gate 1
 	CoinsIs(13, 0, gCoins, "CoinsTaken", t)
 
@@ -311,3 +313,31 @@ func TestVisitBowser(t *testing.T) {
 	CoinsIs(33, 0, gStars, "StarTaken", t)
 	StarsIs(1, 0, gStars, "StarTaken", t)
 }
+
+func TestWarpCLandings(t *testing.T) {
+	g := *mp1.InitializeGame(ES, mp1.GameConfig{MaxTurns: 20})
+	g.Players[0].CurrentSpace = mp1.NewChainSpace(3, 3)
+
+	rl := g.LandingsFor(0, 1)
+	if !rl.CPU {
+		t.Error("Expected an undecided gate to depend on the CPU")
+	}
+	found := map[mp1.ChainSpace]bool{}
+	for _, l := range rl.Landings {
+		found[l.Space] = true
+		if _, ok := l.Decisions[0].Event.(ESWarpCDest); !ok {
+			t.Errorf("Expected %v to be reached through ESWarpCDest, got: %v",
+				l.Space, l.Decisions)
+		}
+	}
+	if !found[mp1.NewChainSpace(10, 1)] || !found[esStartingSpace] {
+		t.Errorf("Expected to land on {10 1} or Start, got: %v", rl.Landings)
+	}
+
+	g.Board.Data = esBoardData{Gate: 1}
+	rl = g.LandingsFor(0, 1)
+	if rl.CPU || len(rl.Landings) != 1 ||
+		rl.Landings[0].Space != mp1.NewChainSpace(10, 1) {
+		t.Errorf("Expected gate 1 to only land on {10 1}, got: %+v", rl)
+	}
+}
@@ -125,7 +125,11 @@ var LER = mp1.Board{
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: lerRBRFork},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: lerRBRFork,
+				Exits:        exits(1, mp1.NewChainSpace(3, 0), mp1.NewChainSpace(11, 0), mp1.NewChainSpace(5, 0)),
+			},
 		},
 		{ //Offshoot to robot
 			{Type: mp1.MinigameSpace},
@@ -146,7 +150,11 @@ var LER = mp1.Board{
 			{Type: mp1.BogusItem},
 			{Type: mp1.Red},
 			{Type: mp1.Happening, StoppingEvent: lerSwapGates},
-			{Type: mp1.Invisible, PassingEvent: lerRBFork},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: lerRBFork,
+				Exits:        exits(1, mp1.NewChainSpace(4, 4), mp1.NewChainSpace(4, 0)),
+			},
 		},
 		{ //Red/blue fork blue path
 			{Type: mp1.Blue},
@@ -160,7 +168,11 @@ var LER = mp1.Board{
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
 			{Type: mp1.Mushroom},
-			{Type: mp1.Invisible, PassingEvent: lerBRFork1},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: lerBRFork1,
+				Exits:        exits(1, mp1.NewChainSpace(9, 0), mp1.NewChainSpace(6, 10)),
+			},
 		},
 		{ //Left of blue/red fork 1 to blue/red fork 2
 			{Type: mp1.Blue},
@@ -174,9 +186,21 @@ var LER = mp1.Board{
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
-			{Type: mp1.Happening, StoppingEvent: lerGotoIsland(0)},
-			{Type: mp1.Happening, StoppingEvent: lerGotoIsland(1)},
-			{Type: mp1.Invisible, PassingEvent: lerBRFork2},
+			{
+				Type:          mp1.Happening,
+				StoppingEvent: lerGotoIsland(0),
+				Exits:         exits(0, mp1.NewChainSpace(8, 0)),
+			},
+			{
+				Type:          mp1.Happening,
+				StoppingEvent: lerGotoIsland(1),
+				Exits:         exits(0, mp1.NewChainSpace(8, 1)),
+			},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: lerBRFork2,
+				Exits:        exits(1, mp1.NewChainSpace(7, 0), mp1.NewChainSpace(6, 0)),
+			},
 		},
 		{ //Past red gate of blue/red fork 2
 			{Type: mp1.Blue},
@@ -197,7 +221,11 @@ var LER = mp1.Board{
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: lerBRFork3},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: lerBRFork3,
+				Exits:        exits(1, mp1.NewChainSpace(0, 0), mp1.NewChainSpace(10, 0)),
+			},
 		},
 		{ //Blue exit of blue/red fork 3
 			{Type: mp1.Blue},
@@ -23,6 +23,16 @@ func (l LERRobotResponse) String() string {
 	return ""
 }
 
+func (l LERRobotResponse) Key() string {
+	switch l {
+	case LERRobotPay:
+		return "LERRobotPay"
+	case LERRobotIgnore:
+		return "LERRobotIgnore"
+	}
+	return ""
+}
+
 //LERRobot let's the player decide to either pay and raise/lower gates or ignore the robot.
 type LERRobot struct {
 	Player int
@@ -44,8 +54,18 @@ func (l LERRobot) ControllingPlayer() int {
 	return l.Player
 }
 
+var lerRobotResponses = []mp1.Response{LERRobotPay, LERRobotIgnore}
+
 func (l LERRobot) Responses() []mp1.Response {
-	return []mp1.Response{LERRobotPay, LERRobotIgnore}
+	return append([]mp1.Response(nil), lerRobotResponses...)
+}
+
+func (l LERRobot) ResponseCount() int {
+	return len(lerRobotResponses)
+}
+
+func (l LERRobot) ResponseAt(i int) mp1.Response {
+	return lerRobotResponses[i]
 }
 
 //Handle pays the robot 20 coins and switches the gates only if r is true.
@@ -20,9 +20,10 @@ func mrcVisitCastle(g *mp1.Game, player int, moves int) int {
 	if bd.IsBowser {
 		g.AwardCoins(player, -40, false)
 	} else {
-		if g.Players[player].Coins >= 20 {
-			g.Players[player].Stars++
-			g.AwardCoins(player, -20, false)
+		price := g.Economy().StarPrice
+		if g.Players[player].Coins >= price {
+			g.AwardStars(player, 1)
+			g.AwardCoins(player, -price, false)
 		}
 	}
 	g.Players[player].CurrentSpace = mp1.NewChainSpace(0, 0)
@@ -98,7 +99,11 @@ var MRC = mp1.Board{
 			{Type: mp1.Blue},
 			{Type: mp1.Chance},
 			{Type: mp1.Bowser},
-			{Type: mp1.Invisible, PassingEvent: mrcVisitCastle},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: mrcVisitCastle,
+				Exits:        exits(0, mp1.NewChainSpace(0, 0)),
+			},
 		},
 	},
 	Links: &map[int]*[]mp1.ChainSpace{
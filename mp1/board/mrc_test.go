@@ -1,41 +1,160 @@
 package board
 
 import (
+	"math/rand"
+	"os"
+	"reflect"
 	"testing"
 
 	"github.com/0xhexnumbers/partysim/mp1"
 )
 
+//mrcBoards returns MRC and MRC loaded from mrc.json, so every MRC test
+//also proves the board file is equivalent.
+func mrcBoards(t *testing.T) map[string]mp1.Board {
+	f, err := os.Open("mrc.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	b, err := mp1.LoadBoard(f)
+	if err != nil {
+		t.Fatalf("Expected no error loading mrc.json, got: %v", err)
+	}
+	return map[string]mp1.Board{"MRC": MRC, "mrc.json": b}
+}
+
+//mrcIsBowser returns whether Bowser's castle is reached on either MRC
+//board.
+func mrcIsBowser(g mp1.Game) bool {
+	switch bd := g.Board.Data.(type) {
+	case mrcBoardData:
+		return bd.IsBowser
+	case mp1.GenericBoardData:
+		return bd.Toggles[0]
+	}
+	panic("unknown MRC board data")
+}
+
 func TestMRCFork(t *testing.T) {
-	g := *mp1.InitializeGame(MRC, mp1.GameConfig{MaxTurns: 25})
-	g.Players[0].CurrentSpace = mp1.NewChainSpace(0, 15)
+	for name, b := range mrcBoards(t) {
+		g := *mp1.InitializeGame(b, mp1.GameConfig{MaxTurns: 25})
+		g.Players[0].CurrentSpace = mp1.NewChainSpace(0, 15)
 
-	g.NextEvent.Handle(1, &g) //Move
-	expectedRes := []mp1.Response{mp1.NewChainSpace(1, 0), mp1.NewChainSpace(2, 0)}
-	ResIs(expectedRes, g, "mp1.ChainSpaces", t)
+		g.NextEvent.Handle(1, &g) //Move
+		expectedRes := []mp1.Response{mp1.NewChainSpace(1, 0), mp1.NewChainSpace(2, 0)}
+		ResIs(expectedRes, g, name+" mp1.ChainSpaces", t)
+	}
 }
 
 func TestSwapCastleDirViaHappening(t *testing.T) {
-	g := *mp1.InitializeGame(MRC, mp1.GameConfig{MaxTurns: 25})
-	g.Players[0].CurrentSpace = mp1.NewChainSpace(0, 6)
+	for name, b := range mrcBoards(t) {
+		g := *mp1.InitializeGame(b, mp1.GameConfig{MaxTurns: 25})
+		g.Players[0].CurrentSpace = mp1.NewChainSpace(0, 6)
 
-	g.NextEvent.Handle(1, &g) //Move
-	bd := g.Board.Data.(mrcBoardData)
-	if !bd.IsBowser {
-		t.Errorf("Bowser is not swapped in")
+		g.NextEvent.Handle(1, &g) //Move
+		if !mrcIsBowser(g) {
+			t.Errorf("%s: Bowser is not swapped in", name)
+		}
 	}
 }
 
 func TestSwapCastleDirViaStar(t *testing.T) {
-	g := *mp1.InitializeGame(MRC, mp1.GameConfig{MaxTurns: 25})
-	g.Players[0].CurrentSpace = mp1.NewChainSpace(4, 7)
+	for name, b := range mrcBoards(t) {
+		g := *mp1.InitializeGame(b, mp1.GameConfig{MaxTurns: 25})
+		g.Players[0].CurrentSpace = mp1.NewChainSpace(4, 7)
+
+		g.NextEvent.Handle(1, &g) //Move
+		if !mrcIsBowser(g) {
+			t.Errorf("%s: Bowser is not swapped in", name)
+		}
+
+		CoinsIs(23, 0, g, name, t)
+		SpaceIs(mp1.NewChainSpace(0, 2), 0, g, name, t)
+	}
+}
+
+func TestMRCEconomy(t *testing.T) {
+	economy := mp1.EconomyConfig{StarPrice: mp1.Amount(5)}
+	for name, b := range mrcBoards(t) {
+		g := *mp1.InitializeGame(b, mp1.GameConfig{
+			MaxTurns: 25,
+			Economy:  economy,
+		})
+		g.Players[0].CurrentSpace = mp1.NewChainSpace(4, 7)
+
+		g.NextEvent.Handle(1, &g) //Move
+		StarsIs(1, 0, g, name, t)
+		CoinsIs(18, 0, g, name, t)
+	}
+}
 
-	g.NextEvent.Handle(1, &g) //Move
-	bd := g.Board.Data.(mrcBoardData)
-	if !bd.IsBowser {
-		t.Errorf("Bowser is not swapped in")
+func TestMRCVisitBowserCastle(t *testing.T) {
+	for name, b := range mrcBoards(t) {
+		g := *mp1.InitializeGame(b, mp1.GameConfig{MaxTurns: 25})
+		g.Players[0].CurrentSpace = mp1.NewChainSpace(0, 6)
+		g.Players[0].Coins = 50
+		g.NextEvent.Handle(1, &g) //Move to happening, Bowser's castle
+		g.Players[1].CurrentSpace = mp1.NewChainSpace(4, 7)
+		g.Players[1].Coins = 50
+		g.NextEvent.Handle(2, &g)  //Pass castle, land on blue
+		CoinsIs(23, 1, g, name, t) //50 - 40 castle + 10 Koopa + 3 blue
+		StarsIs(0, 1, g, name, t)
+		if mrcIsBowser(g) {
+			t.Errorf("%s: Toad is not swapped in", name)
+		}
 	}
+}
 
-	CoinsIs(23, 0, g, "", t)
-	SpaceIs(mp1.NewChainSpace(0, 2), 0, g, "", t)
+func TestMRCFileEquivalence(t *testing.T) {
+	boards := mrcBoards(t)
+	fileBoard := boards["mrc.json"]
+	for ci, chain := range *MRC.Chains {
+		got := (*fileBoard.Chains)[ci]
+		if len(chain) != len(got) {
+			t.Fatalf("Expected chain %d length %d, got: %d",
+				ci, len(chain), len(got))
+		}
+		for si, space := range chain {
+			if space.Type != got[si].Type ||
+				space.HiddenBlock != got[si].HiddenBlock ||
+				(space.StoppingEvent == nil) != (got[si].StoppingEvent == nil) ||
+				(space.PassingEvent == nil) != (got[si].PassingEvent == nil) ||
+				!reflect.DeepEqual(space.Exits, got[si].Exits) {
+				t.Errorf("Expected space %d,%d: %#v, got: %#v",
+					ci, si, space, got[si])
+			}
+		}
+	}
+	if !reflect.DeepEqual(MRC.Links, fileBoard.Links) {
+		t.Errorf("Expected links %v, got: %v", *MRC.Links, *fileBoard.Links)
+	}
+
+	config := mp1.GameConfig{
+		MaxTurns:   20,
+		RedDice:    true,
+		BlueDice:   true,
+		WarpDice:   true,
+		EventsDice: true,
+	}
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		g1 := mp1.InitializeGame(MRC, config)
+		g2 := mp1.InitializeGame(fileBoard, config)
+		for g1.NextEvent != nil {
+			if !reflect.DeepEqual(g1.NextEvent, g2.NextEvent) ||
+				g1.Players != g2.Players ||
+				mrcIsBowser(*g1) != mrcIsBowser(*g2) {
+				t.Fatalf("Seed %d: Expected equal games:\n%#v\n%#v",
+					seed, g1, g2)
+			}
+			res := g1.NextEvent.Responses()
+			choice := res[r.Intn(len(res))]
+			g1.HandleEvent(choice)
+			g2.HandleEvent(choice)
+		}
+		if g2.NextEvent != nil || g1.Players != g2.Players {
+			t.Fatalf("Seed %d: Expected equal results", seed)
+		}
+	}
 }
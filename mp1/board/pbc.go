@@ -45,8 +45,8 @@ func pbcVisitPiranha(piranha int) func(*mp1.Game, int) {
 		if data.PiranhaOccupied[piranha] {
 			owner := data.PiranhaPlant[piranha]
 			if owner != player && g.Players[player].Stars > 0 {
-				g.Players[player].Stars--
-				g.Players[owner].Stars++
+				g.AwardStars(player, -1)
+				g.AwardStars(owner, 1)
 			}
 		} else if g.Players[player].Coins >= 30 {
 			g.NextEvent = PBCPiranhaDecision{player, piranha}
@@ -54,6 +54,9 @@ func pbcVisitPiranha(piranha int) func(*mp1.Game, int) {
 	}
 }
 
+//pbcSeedExits are the Exits of the seed spaces, to Toad or Bowser.
+var pbcSeedExits = exits(1, mp1.NewChainSpace(0, 0), mp1.NewChainSpace(1, 0))
+
 //PBC holds the data for Peach's Birthday Cake.
 var PBC = mp1.Board{
 	Chains: &[]mp1.Chain{
@@ -105,7 +108,11 @@ var PBC = mp1.Board{
 			{Type: mp1.Happening, StoppingEvent: pbcVisitPiranha(12)},
 			{Type: mp1.Happening, StoppingEvent: pbcVisitPiranha(13)},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: pbcVisitSeed},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: pbcVisitSeed,
+				Exits:        pbcSeedExits,
+			},
 		},
 		{ //Bowser Path
 			{Type: mp1.Bowser},
@@ -122,7 +129,11 @@ var PBC = mp1.Board{
 			{Type: mp1.BogusItem},
 			{Type: mp1.Red},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: pbcVisitSeed},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: pbcVisitSeed,
+				Exits:        pbcSeedExits,
+			},
 		},
 	},
 	Links:       nil,
@@ -23,6 +23,16 @@ func (p PBCSeedCheckResponse) String() string {
 	return ""
 }
 
+func (p PBCSeedCheckResponse) Key() string {
+	switch p {
+	case PBCSeedCheckBowser:
+		return "PBCSeedCheckBowser"
+	case PBCSeedCheckToad:
+		return "PBCSeedCheckToad"
+	}
+	return ""
+}
+
 //PBCSeedCheck decides if the player got a toad seed or a bowser seed.
 type PBCSeedCheck struct {
 	Player int
@@ -42,8 +52,18 @@ func (p PBCSeedCheck) ControllingPlayer() int {
 	return mp1.CPU_PLAYER
 }
 
+var pbcSeedCheckResponses = []mp1.Response{PBCSeedCheckBowser, PBCSeedCheckToad}
+
 func (p PBCSeedCheck) Responses() []mp1.Response {
-	return []mp1.Response{PBCSeedCheckBowser, PBCSeedCheckToad}
+	return append([]mp1.Response(nil), pbcSeedCheckResponses...)
+}
+
+func (p PBCSeedCheck) ResponseCount() int {
+	return len(pbcSeedCheckResponses)
+}
+
+func (p PBCSeedCheck) ResponseAt(i int) mp1.Response {
+	return pbcSeedCheckResponses[i]
 }
 
 //Handle moves the player based on r. If r is true, the player moves to the
@@ -79,6 +99,16 @@ func (p PBCPiranhaDecisionResponse) String() string {
 	return ""
 }
 
+func (p PBCPiranhaDecisionResponse) Key() string {
+	switch p {
+	case PBCPiranhaDecisionPay:
+		return "PBCPiranhaDecisionPay"
+	case PBCPiranhaDecisionIgnore:
+		return "PBCPiranhaDecisionIgnore"
+	}
+	return ""
+}
+
 //piranha.
 type PBCPiranhaDecision struct {
 	Player  int
@@ -98,8 +128,21 @@ func (p PBCPiranhaDecision) ControllingPlayer() int {
 	return p.Player
 }
 
+var pbcPiranhaDecisionResponses = []mp1.Response{
+	PBCPiranhaDecisionPay,
+	PBCPiranhaDecisionIgnore,
+}
+
 func (p PBCPiranhaDecision) Responses() []mp1.Response {
-	return []mp1.Response{PBCPiranhaDecisionPay, PBCPiranhaDecisionIgnore}
+	return append([]mp1.Response(nil), pbcPiranhaDecisionResponses...)
+}
+
+func (p PBCPiranhaDecision) ResponseCount() int {
+	return len(pbcPiranhaDecisionResponses)
+}
+
+func (p PBCPiranhaDecision) ResponseAt(i int) mp1.Response {
+	return pbcPiranhaDecisionResponses[i]
 }
 
 //Handle performs the decision r. If r is true, then the player pays 30
@@ -0,0 +1,60 @@
+package board
+
+import "github.com/0xhexnumbers/partysim/mp1"
+
+//init registers every board, event and response in this package so games
+//played on these boards can be saved and loaded.
+func init() {
+	mp1.RegisterBoard("BMM", BMM)
+	mp1.RegisterBoard("DKJA", DKJA)
+	mp1.RegisterBoard("ES", ES)
+	mp1.RegisterBoard("LER", LER)
+	mp1.RegisterBoard("MRC", MRC)
+	mp1.RegisterBoard("PBC", PBC)
+	mp1.RegisterBoard("WBC", WBC)
+	mp1.RegisterBoard("YTI", YTI)
+
+	//BMM
+	mp1.RegisterEvent("BMMBranchPay", BMMBranchPay{})
+	mp1.RegisterEvent("BMMBranchDecision", BMMBranchDecision{})
+	mp1.RegisterEvent("BMMBowserRoulette", BMMBowserRoulette{})
+	mp1.RegisterResponse("BMMBranchPayResponse", BMMBranchPayPay)
+	mp1.RegisterResponse("BMMBowserRouletteResponse", BMMBowserRoulette20Coins)
+
+	//DKJA
+	mp1.RegisterEvent("DKJAWhompEvent", DKJAWhompEvent{})
+	mp1.RegisterResponse("DKJAWhompResponse", DKJAWhompPay)
+
+	//ES
+	mp1.RegisterEvent("ESBranchEvent", ESBranchEvent{})
+	mp1.RegisterEvent("ESVisitBabyBowser", ESVisitBabyBowser{})
+	mp1.RegisterEvent("ESBattleBabyBowser", ESBattleBabyBowser{})
+	mp1.RegisterEvent("ESWarpCDest", ESWarpCDest{})
+	mp1.RegisterEvent("ESWarpDest", ESWarpDest{})
+	mp1.RegisterEvent("ESChangeGates", ESChangeGates{})
+	mp1.RegisterResponse("ESBranchResponse", ESBranchGotoWarp)
+	mp1.RegisterResponse("ESVisitBabyBowserResponse", ESVisitBabyBowserPlay)
+	mp1.RegisterResponse("ESBattleBabyBowserResponse", ESBattleBabyBowserWin)
+	mp1.RegisterResponse("Gate", Gate(0))
+
+	//LER
+	mp1.RegisterEvent("LERRobot", LERRobot{})
+	mp1.RegisterResponse("LERRobotResponse", LERRobotPay)
+
+	//PBC
+	mp1.RegisterEvent("PBCSeedCheck", PBCSeedCheck{})
+	mp1.RegisterEvent("PBCPiranhaDecision", PBCPiranhaDecision{})
+	mp1.RegisterResponse("PBCSeedCheckResponse", PBCSeedCheckBowser)
+	mp1.RegisterResponse("PBCPiranhaDecisionResponse", PBCPiranhaDecisionPay)
+
+	//WBC
+	mp1.RegisterEvent("WBCCannon", WBCCannon{})
+	mp1.RegisterEvent("WBCBowserCannon", WBCBowserCannon{})
+	mp1.RegisterEvent("WBCShyGuyEvent", WBCShyGuyEvent{})
+	mp1.RegisterResponse("WBCShyGuyResponse", WBCShyGuyResponse{})
+
+	//YTI
+	mp1.RegisterEvent("YTIThwompBranchEvent", YTIThwompBranchEvent{})
+	mp1.RegisterEvent("YTIPayThwompEvent", YTIPayThwompEvent{})
+	mp1.RegisterResponse("YTIThwompBranchResponse", YTIThwompBranchPay)
+}
@@ -0,0 +1,364 @@
+package board
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+var allBoards = []string{"BMM", "DKJA", "ES", "LER", "MRC", "PBC", "WBC", "YTI"}
+
+func TestBoardsRegistered(t *testing.T) {
+	if !reflect.DeepEqual(allBoards, mp1.BoardNames()) {
+		t.Errorf("Expected boards: %v, got: %v", allBoards, mp1.BoardNames())
+	}
+	for _, name := range allBoards {
+		b, _ := mp1.LookupBoard(name)
+		got, ok := mp1.BoardName(b)
+		if !ok || got != name {
+			t.Errorf("Expected board name: %s, got: %s", name, got)
+		}
+	}
+}
+
+func TestSaveLoadRandomGames(t *testing.T) {
+	config := mp1.GameConfig{
+		MaxTurns:   20,
+		RedDice:    true,
+		BlueDice:   true,
+		WarpDice:   true,
+		EventsDice: true,
+	}
+	for i, name := range allBoards {
+		b, _ := mp1.LookupBoard(name)
+		r := rand.New(rand.NewSource(int64(i)))
+		g := mp1.InitializeGame(b, config)
+		for g.NextEvent != nil {
+			var buf bytes.Buffer
+			if err := mp1.SaveGame(&buf, g); err != nil {
+				t.Fatalf("%s: Expected no save error, got: %v", name, err)
+			}
+			loaded, err := mp1.LoadGame(&buf)
+			if err != nil {
+				t.Fatalf("%s: Expected no load error, got: %v", name, err)
+			}
+			if !reflect.DeepEqual(g, loaded) {
+				t.Fatalf("%s: Expected game:\n%#v\ngot:\n%#v",
+					name, g, loaded)
+			}
+			if loaded.Hash() != g.Hash() || !loaded.Equal(g) {
+				t.Fatalf("%s: Expected loaded game to have the same state",
+					name)
+			}
+
+			res := loaded.NextEvent.Responses()
+			g = loaded
+			g.HandleEvent(res[r.Intn(len(res))])
+		}
+	}
+}
+
+func TestValidateBoards(t *testing.T) {
+	for _, name := range allBoards {
+		b, _ := mp1.LookupBoard(name)
+		for _, err := range mp1.ValidateBoard(b) {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+	for _, err := range mp1.ValidateBoard(mrcBoards(t)["mrc.json"]) {
+		t.Errorf("mrc.json: %v", err)
+	}
+}
+
+func TestEventsIndexed(t *testing.T) {
+	events := []mp1.Event{
+		BMMBowserRoulette{}, BMMBranchDecision{}, BMMBranchPay{},
+		DKJAWhompEvent{},
+		ESBattleBabyBowser{}, ESBranchEvent{}, ESChangeGates{},
+		ESVisitBabyBowser{}, ESWarpCDest{}, ESWarpDest{},
+		LERRobot{},
+		PBCPiranhaDecision{}, PBCSeedCheck{},
+		WBCBowserCannon{}, WBCCannon{}, WBCShyGuyEvent{},
+		YTIPayThwompEvent{}, YTIThwompBranchEvent{},
+	}
+	for _, e := range events {
+		if _, ok := e.(mp1.IndexedEvent); !ok {
+			t.Errorf("%T is not an IndexedEvent", e)
+		}
+	}
+}
+
+func TestEnumsKeyed(t *testing.T) {
+	enums := []mp1.Response{
+		BMMBowserRouletteResponse(0), BMMBranchPayResponse(0),
+		DKJAWhompResponse(0),
+		ESBattleBabyBowserResponse(0), ESBranchResponse(0),
+		ESVisitBabyBowserResponse(0),
+		LERRobotResponse(0),
+		PBCPiranhaDecisionResponse(0), PBCSeedCheckResponse(0),
+		YTIThwompBranchResponse(0),
+	}
+	for _, enum := range enums {
+		seen := map[string]bool{}
+		for i := 0; ; i++ {
+			v := reflect.New(reflect.TypeOf(enum)).Elem()
+			v.SetInt(int64(i))
+			if fmt.Sprint(v.Interface()) == "" {
+				break
+			}
+			k, ok := v.Interface().(mp1.Keyed)
+			if !ok || k.Key() == "" || seen[k.Key()] {
+				t.Errorf("%T %d has no key of its own", enum, i)
+				break
+			}
+			seen[k.Key()] = true
+		}
+	}
+}
+
+func TestIndexedResponsesRandomGames(t *testing.T) {
+	config := mp1.GameConfig{
+		MaxTurns:   20,
+		RedDice:    true,
+		BlueDice:   true,
+		WarpDice:   true,
+		EventsDice: true,
+	}
+	for i, name := range allBoards {
+		b, _ := mp1.LookupBoard(name)
+		r := rand.New(rand.NewSource(int64(i)))
+		for game := 0; game < 10; game++ {
+			g := mp1.InitializeGame(b, config)
+			for g.NextEvent != nil {
+				e := g.NextEvent.(mp1.IndexedEvent)
+				res := e.Responses()
+				if e.ResponseCount() != len(res) {
+					t.Fatalf("%s: Expected %d responses of %#v, got: %d",
+						name, len(res), e, e.ResponseCount())
+				}
+				for j, want := range res {
+					if got := e.ResponseAt(j); got != want {
+						t.Fatalf("%s: Expected response %d of %#v: %#v, got: %#v",
+							name, j, e, want, got)
+					}
+				}
+				//Responses belong to the caller, so changing them must
+				//not change the event's tables.
+				for j := range res {
+					res[j] = nil
+				}
+				if e.ResponseAt(0) == nil {
+					t.Fatalf("%s: Expected a copy of the responses of %#v",
+						name, e)
+				}
+				g.HandleEvent(e.ResponseAt(r.Intn(len(res))))
+			}
+		}
+	}
+}
+
+func TestDescribeRandomGames(t *testing.T) {
+	config := mp1.GameConfig{
+		MaxTurns:   20,
+		RedDice:    true,
+		BlueDice:   true,
+		WarpDice:   true,
+		EventsDice: true,
+	}
+	for i, name := range allBoards {
+		b, _ := mp1.LookupBoard(name)
+		r := rand.New(rand.NewSource(int64(i)))
+		for game := 0; game < 5; game++ {
+			g := mp1.InitializeGame(b, config)
+			for g.NextEvent != nil {
+				e := g.NextEvent
+				d := mp1.Describe(g, e)
+				if _, ok := mp1.RegisteredName(e); !ok || d.Kind == "" {
+					t.Fatalf("%s: Expected %#v to be described, got: %#v",
+						name, e, d)
+				}
+				ids := map[string]bool{}
+				for _, opt := range d.Options {
+					if ids[opt.ID] {
+						t.Fatalf("%s: %T has ID %s twice", name, e, opt.ID)
+					}
+					ids[opt.ID] = true
+					if _, ok := mp1.RegisteredName(opt.Value); !ok {
+						t.Fatalf("%s: %T response %#v is not registered",
+							name, e, opt.Value)
+					}
+					if res, ok := mp1.ResponseByID(e, opt.ID); !ok || res != opt.Value {
+						t.Fatalf("%s: Expected %s to be %#v, got: %#v",
+							name, opt.ID, opt.Value, res)
+					}
+					OptionKindIs(d.Kind, opt, name, t)
+				}
+				opt := d.Options[r.Intn(len(d.Options))]
+				if err := g.ApplyID(opt.ID); err != nil {
+					t.Fatalf("%s: %v", name, err)
+				}
+			}
+		}
+	}
+}
+
+//OptionKindIs checks that opt's value has the Go type its kind promises.
+func OptionKindIs(kind string, opt mp1.ResponseOption, flavour string, t *testing.T) {
+	t.Helper()
+	v, isInt := opt.Value.(int)
+	var ok bool
+	switch kind {
+	case "range", "coin":
+		ok = isInt
+	case "player":
+		ok = isInt && v >= 0 && v <= 4
+	case "multiwin_player":
+		ok = isInt && v >= 0 && v <= 15
+	case "chainspace":
+		_, ok = opt.Value.(mp1.ChainSpace)
+		ok = ok && opt.SpaceType != ""
+	default:
+		ok = true
+	}
+	if !ok {
+		t.Errorf("%s: Expected %s option, got: %#v", flavour, kind, opt)
+	}
+}
+
+func TestExitsRandomGames(t *testing.T) {
+	config := mp1.GameConfig{
+		MaxTurns:   20,
+		RedDice:    true,
+		BlueDice:   true,
+		WarpDice:   true,
+		EventsDice: true,
+	}
+	boards := map[string]mp1.Board{}
+	for _, name := range allBoards {
+		boards[name], _ = mp1.LookupBoard(name)
+	}
+	boards["mrc.json"] = mrcBoards(t)["mrc.json"]
+	for name, b := range boards {
+		w := &exitsWatcher{board: b, name: name, t: t}
+		wrapped := w.wrap()
+		r := rand.New(rand.NewSource(0))
+		for game := 0; game < 10; game++ {
+			g := mp1.InitializeGame(wrapped, config)
+			g.AddListener(w)
+			w.armed = false
+			for g.NextEvent != nil {
+				res := g.NextEvent.Responses()
+				g.HandleEvent(res[r.Intn(len(res))])
+				w.observe(g, w.player)
+				if g.CurrentPlayer != w.player ||
+					(g.Phase != mp1.MovePhase && g.Phase != mp1.LandPhase) {
+					w.armed = false
+				}
+			}
+		}
+	}
+}
+
+//exitsWatcher checks that players moved by a space's events carry on
+//from one of the space's Exits.
+type exitsWatcher struct {
+	board mp1.Board
+	name  string
+	t     *testing.T
+
+	//armed is set while player may still be moved by the events of the
+	//space at from.
+	armed  bool
+	player int
+	from   mp1.ChainSpace
+}
+
+//wrap returns a copy of the watched board whose space events arm w.
+func (w *exitsWatcher) wrap() mp1.Board {
+	b := w.board
+	chains := make([]mp1.Chain, len(*b.Chains))
+	for ci, chain := range *b.Chains {
+		chains[ci] = append(mp1.Chain{}, chain...)
+		for si := range chain {
+			pos := mp1.NewChainSpace(ci, si)
+			space := &chains[ci][si]
+			if pass := space.PassingEvent; pass != nil {
+				space.PassingEvent = func(g *mp1.Game, player, moves int) int {
+					w.arm(g, player, pos)
+					moves = pass(g, player, moves)
+					w.observe(g, player)
+					return moves
+				}
+			}
+			if stop := space.StoppingEvent; stop != nil {
+				space.StoppingEvent = func(g *mp1.Game, player int) {
+					w.arm(g, player, pos)
+					stop(g, player)
+					w.observe(g, player)
+				}
+			}
+		}
+	}
+	b.Chains = &chains
+	return b
+}
+
+func (w *exitsWatcher) Notify(g *mp1.Game, e mp1.GameEvent) {
+	switch e := e.(type) {
+	case mp1.SpacePassed:
+		w.observe(g, e.Player)
+	case mp1.SpaceLanded:
+		w.observe(g, e.Player)
+	}
+}
+
+func (w *exitsWatcher) arm(g *mp1.Game, player int, pos mp1.ChainSpace) {
+	w.observe(g, player)
+	w.armed, w.player, w.from = true, player, pos
+}
+
+//observe checks the space of player once they have left the armed space.
+//They must be on one of its Exits or a step away from one.
+func (w *exitsWatcher) observe(g *mp1.Game, player int) {
+	pos := g.Players[player].CurrentSpace
+	if !w.armed || player != w.player || pos == w.from {
+		return
+	}
+	w.armed = false
+	space := (*w.board.Chains)[w.from.Chain][w.from.Space]
+	exits := space.Exits
+	if space.PassingEvent != nil && len(exits) == 0 {
+		exits = []mp1.Exit{{Dest: w.from}}
+	}
+	for _, exit := range exits {
+		if pos == exit.Dest {
+			return
+		}
+		for _, n := range nextSpaces(w.board, exit.Dest) {
+			if pos == n {
+				return
+			}
+		}
+	}
+	w.t.Errorf("%s: Player %d moved from %v to %v, which is not one of "+
+		"its Exits %v", w.name, player, w.from, pos, exits)
+}
+
+//nextSpaces returns the spaces a player on pos steps on with their next
+//move.
+func nextSpaces(b mp1.Board, pos mp1.ChainSpace) []mp1.ChainSpace {
+	if pos.Space+1 < len((*b.Chains)[pos.Chain]) {
+		return []mp1.ChainSpace{mp1.NewChainSpace(pos.Chain, pos.Space+1)}
+	}
+	if b.Links != nil {
+		if links, ok := (*b.Links)[pos.Chain]; ok && links != nil {
+			return *links
+		}
+		return []mp1.ChainSpace{mp1.NewChainSpace(pos.Chain, 0)}
+	}
+	return nil
+}
@@ -1,5 +1,7 @@
 package board
 
+import "github.com/0xhexnumbers/partysim/mp1"
+
 func max(x, y int) int {
 	if x > y {
 		return x
@@ -13,3 +15,14 @@ func min(x, y int) int {
 	}
 	return y
 }
+
+//exits returns an Exit to each of dests, using up moves moves. Dests are
+//ChainSpaces, taken as Responses so the responses of warp events can be
+//passed as they are.
+func exits(moves int, dests ...mp1.Response) []mp1.Exit {
+	ret := make([]mp1.Exit, len(dests))
+	for i, dest := range dests {
+		ret[i] = mp1.Exit{Dest: dest.(mp1.ChainSpace), Moves: moves}
+	}
+	return ret
+}
@@ -65,7 +65,14 @@ var WBC = mp1.Board{
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: wbcCannonShot},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: wbcCannonShot,
+				Exits: append(
+					exits(0, wbcCannonDestinationsPerChain[1]...),
+					exits(0, wbcCannonDestinationsPerChain[3]...)...,
+				),
+			},
 		},
 		{ //Bottom Right
 			{Type: mp1.Blue},
@@ -87,7 +94,14 @@ var WBC = mp1.Board{
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: wbcCannonShot},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: wbcCannonShot,
+				Exits: append(
+					exits(0, wbcCannonDestinationsPerChain[2]...),
+					exits(0, wbcCannonDestinationsPerChain[0]...)...,
+				),
+			},
 		},
 		{ //Top Left
 			{Type: mp1.Blue},
@@ -108,7 +122,14 @@ var WBC = mp1.Board{
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: wbcCannonShot},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: wbcCannonShot,
+				Exits: append(
+					exits(0, wbcCannonDestinationsPerChain[3]...),
+					exits(0, wbcCannonDestinationsPerChain[1]...)...,
+				),
+			},
 		},
 		{ //Top Right
 			{Type: mp1.Red},
@@ -116,7 +137,14 @@ var WBC = mp1.Board{
 			{Type: mp1.Red},
 			{Type: mp1.Red},
 			{Type: mp1.Red},
-			{Type: mp1.Invisible, PassingEvent: wbcShyGuy},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: wbcShyGuy,
+				Exits: append(
+					exits(0, mp1.NewChainSpace(3, 5)),
+					exits(0, wbcCannonDestinationsPerChain[4]...)...,
+				),
+			},
 			{Type: mp1.Red},
 			{Type: mp1.Bowser},
 			{Type: mp1.Red},
@@ -124,7 +152,14 @@ var WBC = mp1.Board{
 			{Type: mp1.Star},
 			{Type: mp1.Blue},
 			{Type: mp1.Red},
-			{Type: mp1.Invisible, PassingEvent: wbcCannonShot},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: wbcCannonShot,
+				Exits: append(
+					exits(0, wbcCannonDestinationsPerChain[0]...),
+					exits(0, wbcCannonDestinationsPerChain[2]...)...,
+				),
+			},
 		},
 		{ //Center
 			{Type: mp1.MinigameSpace},
@@ -135,7 +170,11 @@ var WBC = mp1.Board{
 			{Type: mp1.MinigameSpace},
 			{Type: mp1.MinigameSpace},
 			{Type: mp1.BogusItem},
-			{Type: mp1.Invisible, PassingEvent: wbcLoadPlayerInBowserCannon},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: wbcLoadPlayerInBowserCannon,
+				Exits:        exits(0, wbcCannonDestinations[:62]...),
+			},
 		},
 	},
 	Links:       nil,
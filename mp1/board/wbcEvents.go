@@ -105,7 +105,16 @@ func (w WBCCannon) Type() mp1.EventType {
 //Responses returns a slice of possible positions the player can land on.
 func (w WBCCannon) Responses() []mp1.Response {
 	//TODO: Handle star spaces
-	return wbcCannonDestinationsPerChain[w.Chain]
+	res := wbcCannonDestinationsPerChain[w.Chain]
+	return append([]mp1.Response(nil), res...)
+}
+
+func (w WBCCannon) ResponseCount() int {
+	return len(wbcCannonDestinationsPerChain[w.Chain])
+}
+
+func (w WBCCannon) ResponseAt(i int) mp1.Response {
+	return wbcCannonDestinationsPerChain[w.Chain][i]
 }
 
 func (w WBCCannon) ControllingPlayer() int {
@@ -136,7 +145,16 @@ func (w WBCBowserCannon) Type() mp1.EventType {
 
 //Responses returns a slice of ints from [0, 4].
 func (w WBCBowserCannon) Responses() []mp1.Response {
-	return wbcCannonDestinations[:62]
+	res := wbcCannonDestinations[:62]
+	return append([]mp1.Response(nil), res...)
+}
+
+func (w WBCBowserCannon) ResponseCount() int {
+	return len(wbcCannonDestinations[:62])
+}
+
+func (w WBCBowserCannon) ResponseAt(i int) mp1.Response {
+	return wbcCannonDestinations[:62][i]
 }
 
 func (w WBCBowserCannon) ControllingPlayer() int {
@@ -230,7 +248,16 @@ func (w WBCShyGuyEvent) Type() mp1.EventType {
 
 //Responses returns the available responses a player can take.
 func (w WBCShyGuyEvent) Responses() []mp1.Response {
-	return wbcShyGuyResponses[w.Player]
+	res := wbcShyGuyResponses[w.Player]
+	return append([]mp1.Response(nil), res...)
+}
+
+func (w WBCShyGuyEvent) ResponseCount() int {
+	return len(wbcShyGuyResponses[w.Player])
+}
+
+func (w WBCShyGuyEvent) ResponseAt(i int) mp1.Response {
+	return wbcShyGuyResponses[w.Player][i]
 }
 
 func (w WBCShyGuyEvent) ControllingPlayer() int {
@@ -66,3 +66,24 @@ func TestShyGuy(t *testing.T) {
 	SpaceIs(mp1.NewChainSpace(3, 6), 0, gBringPlayer, "BringPlayer0", t)
 	SpaceIs(mp1.NewChainSpace(3, 4), 1, gBringPlayer, "BringPlayer1", t)
 }
+
+func TestCannonLandings(t *testing.T) {
+	g := *mp1.InitializeGame(WBC, mp1.GameConfig{MaxTurns: 20})
+	g.Players[0].CurrentSpace = mp1.NewChainSpace(0, 13)
+
+	rl := g.LandingsFor(0, 1)
+	if !rl.CPU {
+		t.Error("Expected the cannon to depend on the CPU")
+	}
+	for _, l := range rl.Landings {
+		d := l.Decisions[0]
+		if _, ok := d.Event.(WBCCannon); !ok ||
+			d.Response.(mp1.ChainSpace).Chain != 1 {
+			t.Errorf("Expected %v to be reached by a shot to chain 1, got: %v",
+				l.Space, l.Decisions)
+		}
+	}
+	if len(rl.Landings) < 2 {
+		t.Errorf("Expected several landings, got: %v", rl.Landings)
+	}
+}
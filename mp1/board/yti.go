@@ -42,14 +42,15 @@ func ytiSwapStarPosition(g *mp1.Game, player int) {
 	g.Board.Data = bd
 }
 
-//ytiGainStar will increment the player's star count if they have >=20
-//coins.
+//ytiGainStar will increment the player's star count if they have enough
+//coins to buy a star.
 func ytiGainStar(g *mp1.Game, player, moves int) int {
 	bd := g.Board.Data.(ytiBoardData)
 	if bd.StarPosition == g.Players[player].CurrentSpace {
-		if g.Players[player].Coins >= 20 {
-			g.AwardCoins(player, -20, false)
-			g.Players[player].Stars++
+		price := g.Economy().StarPrice
+		if g.Players[player].Coins >= price {
+			g.AwardCoins(player, -price, false)
+			g.AwardStars(player, 1)
 			ytiSwapStarPosition(g, 0)
 		}
 	} else { //Star at other island
@@ -93,7 +94,14 @@ var YTI = mp1.Board{
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: ytiCheckThwomp(0)},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: ytiCheckThwomp(0),
+				Exits: []mp1.Exit{
+					{Dest: mp1.NewChainSpace(1, 6), Moves: 1, Coins: 1},
+					{Dest: mp1.NewChainSpace(0, 0), Moves: 1},
+				},
+			},
 		},
 		{ //Right island part 1
 			{Type: mp1.Blue}, //Branch #2 Dir A
@@ -124,7 +132,14 @@ var YTI = mp1.Board{
 			{Type: mp1.Red},
 			{Type: mp1.Happening, StoppingEvent: ytiSwapStarPosition},
 			{Type: mp1.Blue},
-			{Type: mp1.Invisible, PassingEvent: ytiCheckThwomp(1)},
+			{
+				Type:         mp1.Invisible,
+				PassingEvent: ytiCheckThwomp(1),
+				Exits: []mp1.Exit{
+					{Dest: mp1.NewChainSpace(0, 7), Moves: 1, Coins: 1},
+					{Dest: mp1.NewChainSpace(1, 0), Moves: 1},
+				},
+			},
 		},
 	},
 	Links: nil,
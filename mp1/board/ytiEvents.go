@@ -23,6 +23,16 @@ func (y YTIThwompBranchResponse) String() string {
 	return ""
 }
 
+func (y YTIThwompBranchResponse) Key() string {
+	switch y {
+	case YTIThwompBranchPay:
+		return "YTIThwompBranchPay"
+	case YTIThwompBranchIgnore:
+		return "YTIThwompBranchIgnore"
+	}
+	return ""
+}
+
 //YTIThwompBranchEvent let's the player decide to go and pay the thwomp an
 //amount of coins or ignore the thwomp.
 type YTIThwompBranchEvent struct {
@@ -44,11 +54,21 @@ func (y YTIThwompBranchEvent) ControllingPlayer() int {
 	return y.Player
 }
 
+var ytiThwompBranchResponses = []mp1.Response{
+	YTIThwompBranchPay,
+	YTIThwompBranchIgnore,
+}
+
 func (y YTIThwompBranchEvent) Responses() []mp1.Response {
-	return []mp1.Response{
-		YTIThwompBranchPay,
-		YTIThwompBranchIgnore,
-	}
+	return append([]mp1.Response(nil), ytiThwompBranchResponses...)
+}
+
+func (y YTIThwompBranchEvent) ResponseCount() int {
+	return len(ytiThwompBranchResponses)
+}
+
+func (y YTIThwompBranchEvent) ResponseAt(i int) mp1.Response {
+	return ytiThwompBranchResponses[i]
 }
 
 //Handle calculates the next action based on r. If r is true, then the
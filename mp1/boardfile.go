@@ -0,0 +1,330 @@
+package mp1
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+//MaxToggles is the number of toggles a board file can use.
+const MaxToggles = 8
+
+//GenericBoardData is the board data of boards loaded from board files.
+type GenericBoardData struct {
+	//Toggles are on/off states flipped by the toggle behavior, such as
+	//which castle is reached at the end of Mario's Rainbow Castle.
+	Toggles [MaxToggles]bool
+}
+
+//Behavior is a named space action that board files can attach to spaces.
+//It is called with the number of moves left when a player passes the
+//space, or 0 when a player stops on it, and returns the number of moves
+//left afterwards.
+type Behavior func(g *Game, player, moves int, spec BehaviorSpec) int
+
+//BehaviorSpec is a behavior attached to a space in a board file, along
+//with its parameters. Which parameters are used depends on the behavior.
+type BehaviorSpec struct {
+	Behavior string  `json:"behavior"`
+	Toggle   int     `json:"toggle,omitempty"`
+	Coins    int     `json:"coins,omitempty"`
+	Dest     *[2]int `json:"dest,omitempty"`
+	//When, if set, only runs the behavior if a toggle is in a state.
+	When *Condition `json:"when,omitempty"`
+}
+
+//Condition holds if Toggles[Toggle] == Is.
+type Condition struct {
+	Toggle int  `json:"toggle"`
+	Is     bool `json:"is"`
+}
+
+//dest returns the spec's destination.
+func (s BehaviorSpec) dest() ChainSpace {
+	return ChainSpace{s.Dest[0], s.Dest[1]}
+}
+
+var behaviors = map[string]Behavior{
+	"toggle":   toggleBehavior,
+	"warp":     warpBehavior,
+	"coins":    coinsBehavior,
+	"buyStar":  buyStarBehavior,
+	"starSwap": starSwapBehavior,
+}
+
+//RegisterBehavior adds b to the behavior catalog under name, so it can be
+//used in board files. It panics if name is already registered.
+//
+//The built-in behaviors are:
+//
+//	toggle:   flips Toggles[toggle].
+//	warp:     moves the player to dest. Passing players keep moving from
+//	          there.
+//	coins:    gives the player coins, or takes them with negative coins,
+//	          which makes a toll gate.
+//	buyStar:  gives the player a star for coins, or the game's
+//	          Economy().StarPrice if unset, if they can afford it.
+//	starSwap: moves the star to the board's other star space. The board
+//	          needs exactly 2 star spaces.
+func RegisterBehavior(name string, b Behavior) {
+	if _, ok := behaviors[name]; ok {
+		panic("mp1: behavior " + name + " registered twice")
+	}
+	behaviors[name] = b
+}
+
+//Behaviors returns the names of every behavior in the catalog, sorted.
+func Behaviors() []string {
+	var ret []string
+	for name := range behaviors {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func toggleBehavior(g *Game, player, moves int, spec BehaviorSpec) int {
+	bd := g.Board.Data.(GenericBoardData)
+	bd.Toggles[spec.Toggle] = !bd.Toggles[spec.Toggle]
+	g.Board.Data = bd
+	return moves
+}
+
+func warpBehavior(g *Game, player, moves int, spec BehaviorSpec) int {
+	g.Players[player].CurrentSpace = spec.dest()
+	return moves
+}
+
+func coinsBehavior(g *Game, player, moves int, spec BehaviorSpec) int {
+	g.AwardCoins(player, spec.Coins, false)
+	return moves
+}
+
+func buyStarBehavior(g *Game, player, moves int, spec BehaviorSpec) int {
+	price := spec.Coins
+	if price == 0 {
+		price = g.Economy().StarPrice
+	}
+	if g.Players[player].Coins >= price {
+		g.AwardStars(player, 1)
+		g.AwardCoins(player, -price, false)
+	}
+	return moves
+}
+
+func starSwapBehavior(g *Game, player, moves int, spec BehaviorSpec) int {
+	if g.StarSpaces.StarSpaceCount != 2 {
+		return moves
+	}
+	stars := *g.StarSpaces.IndexToPosition
+	if g.StarSpaces.CurrentStarSpace == stars[0] {
+		g.StarSpaces.CurrentStarSpace = stars[1]
+	} else {
+		g.StarSpaces.CurrentStarSpace = stars[0]
+	}
+	return moves
+}
+
+//boardFile is the JSON layout of a board file.
+type boardFile struct {
+	Chains []struct {
+		Name   string      `json:"name"`
+		Spaces []spaceFile `json:"spaces"`
+	} `json:"chains"`
+	Links       map[int][][2]int `json:"links"`
+	BowserCoins int              `json:"bowserCoins"`
+	Toggles     []bool           `json:"toggles"`
+}
+
+//spaceFile is the JSON layout of a space in a board file.
+type spaceFile struct {
+	Type        string         `json:"type"`
+	HiddenBlock bool           `json:"hiddenBlock"`
+	Stop        []BehaviorSpec `json:"stop"`
+	Pass        []BehaviorSpec `json:"pass"`
+}
+
+//LoadBoard reads a board file from r and builds its Board. A board file
+//looks like:
+//
+//	{
+//	  "chains": [
+//	    {"name": "Start", "spaces": [
+//	      {"type": "Start"},
+//	      {"type": "Blue", "hiddenBlock": true},
+//	      {"type": "Happening", "stop": [{"behavior": "toggle", "toggle": 0}]},
+//	      {"type": "Invisible", "pass": [{"behavior": "warp", "dest": [0, 0]}]}
+//	    ]}
+//	  ],
+//	  "links": {"0": [[1, 0], [2, 0]]},
+//	  "bowserCoins": 0,
+//	  "toggles": [false]
+//	}
+//
+//Space types are named as by SpaceType.String. Links map a chain to the
+//[chain, space] pairs a player can move to after its last space. "stop"
+//behaviors run when a player stops on a Happening space and
+//"pass" behaviors run when a player passes an Invisible space, in order.
+//The board's Data is a GenericBoardData holding the initial toggles.
+func LoadBoard(r io.Reader) (Board, error) {
+	var f boardFile
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		return Board{}, fmt.Errorf("mp1: reading board file: %v", err)
+	}
+	if len(f.Toggles) > MaxToggles {
+		return Board{}, fmt.Errorf("mp1: %d toggles, at most %d are allowed",
+			len(f.Toggles), MaxToggles)
+	}
+	var data GenericBoardData
+	copy(data.Toggles[:], f.Toggles)
+	b := Board{BowserCoins: f.BowserCoins, Data: data}
+
+	if len(f.Chains) == 0 {
+		return Board{}, fmt.Errorf("mp1: board file has no chains")
+	}
+	chains := make([]Chain, len(f.Chains))
+	for ci, fc := range f.Chains {
+		if len(fc.Spaces) == 0 {
+			return Board{}, fmt.Errorf("mp1: chain %d has no spaces", ci)
+		}
+		chains[ci] = make(Chain, len(fc.Spaces))
+	}
+	b.Chains = &chains
+	for ci, fc := range f.Chains {
+		for si, fs := range fc.Spaces {
+			space, err := b.buildSpace(ChainSpace{ci, si}, fs)
+			if err != nil {
+				return Board{}, fmt.Errorf("mp1: chain %d space %d: %v",
+					ci, si, err)
+			}
+			chains[ci][si] = space
+		}
+	}
+
+	if f.Links != nil {
+		links := map[int]*[]ChainSpace{}
+		for chain, targets := range f.Links {
+			if chain < 0 || chain >= len(chains) {
+				return Board{}, fmt.Errorf("mp1: links of unknown chain %d",
+					chain)
+			}
+			cs := make([]ChainSpace, len(targets))
+			for i, target := range targets {
+				cs[i] = ChainSpace{target[0], target[1]}
+				if !b.has(cs[i]) {
+					return Board{}, fmt.Errorf("mp1: chain %d links to "+
+						"unknown space %v", chain, target)
+				}
+			}
+			links[chain] = &cs
+		}
+		b.Links = &links
+	}
+	return b, nil
+}
+
+//buildSpace builds the Space at pos described by fs on b.
+func (b Board) buildSpace(pos ChainSpace, fs spaceFile) (Space, error) {
+	space := Space{HiddenBlock: fs.HiddenBlock}
+	typ, ok := spaceTypeByName(fs.Type)
+	if !ok {
+		return Space{}, fmt.Errorf("unknown space type %q", fs.Type)
+	}
+	space.Type = typ
+	if len(fs.Stop) > 0 {
+		//Behaviors don't set the landed space type, which Invisible
+		//stopping events must do
+		if typ != Happening {
+			return Space{}, fmt.Errorf("stop behaviors on a %s space", typ)
+		}
+		stop, err := b.behaviorChain(fs.Stop)
+		if err != nil {
+			return Space{}, err
+		}
+		space.StoppingEvent = func(g *Game, player int) {
+			stop(g, player, 0)
+		}
+		space.Exits = warpExits(fs.Stop)
+	}
+	if len(fs.Pass) > 0 {
+		if typ != Invisible {
+			return Space{}, fmt.Errorf("pass behaviors on a %s space", typ)
+		}
+		pass, err := b.behaviorChain(fs.Pass)
+		if err != nil {
+			return Space{}, err
+		}
+		space.PassingEvent = pass
+		space.Exits = warpExits(fs.Pass)
+		for _, spec := range fs.Pass {
+			if spec.Behavior == "warp" && spec.When != nil {
+				//The warp may not run, so players may keep walking
+				space.Exits = append(space.Exits, Exit{Dest: pos})
+				break
+			}
+		}
+	}
+	return space, nil
+}
+
+//warpExits returns an Exit to the dest of every warp in specs. Moves
+//made by registered behaviors are not known.
+func warpExits(specs []BehaviorSpec) []Exit {
+	var exits []Exit
+	for _, spec := range specs {
+		if spec.Behavior == "warp" {
+			exits = append(exits, Exit{Dest: spec.dest()})
+		}
+	}
+	return exits
+}
+
+//behaviorChain validates specs and returns a function running them in
+//order.
+func (b Board) behaviorChain(specs []BehaviorSpec) (func(*Game, int, int) int, error) {
+	fns := make([]Behavior, len(specs))
+	for i, spec := range specs {
+		fn, ok := behaviors[spec.Behavior]
+		if !ok {
+			return nil, fmt.Errorf("unknown behavior %q", spec.Behavior)
+		}
+		if spec.Toggle < 0 || spec.Toggle >= MaxToggles ||
+			(spec.When != nil &&
+				(spec.When.Toggle < 0 || spec.When.Toggle >= MaxToggles)) {
+			return nil, fmt.Errorf("%s: toggle out of range", spec.Behavior)
+		}
+		if spec.Behavior == "warp" && spec.Dest == nil {
+			return nil, fmt.Errorf("warp without dest")
+		}
+		if spec.Dest != nil && !b.has(spec.dest()) {
+			return nil, fmt.Errorf("%s: unknown dest %v", spec.Behavior,
+				*spec.Dest)
+		}
+		fns[i] = fn
+	}
+	return func(g *Game, player, moves int) int {
+		for i, spec := range specs {
+			if spec.When != nil {
+				bd := g.Board.Data.(GenericBoardData)
+				if bd.Toggles[spec.When.Toggle] != spec.When.Is {
+					continue
+				}
+			}
+			moves = fns[i](g, player, moves, spec)
+		}
+		return moves
+	}, nil
+}
+
+//spaceTypeByName returns the SpaceType whose String is name.
+func spaceTypeByName(name string) (SpaceType, bool) {
+	for t := Invisible; t <= Boo; t++ {
+		if t.String() == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,264 @@
+package mp1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func LoadBoardString(t *testing.T, s string) Board {
+	t.Helper()
+	b, err := LoadBoard(strings.NewReader(s))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	return b
+}
+
+func TestLoadBoard(t *testing.T) {
+	b := LoadBoardString(t, `{
+		"chains": [
+			{"name": "Main", "spaces": [
+				{"type": "Start"},
+				{"type": "Blue", "hiddenBlock": true},
+				{"type": "Bogus Item"}
+			]},
+			{"spaces": [{"type": "Star"}]}
+		],
+		"links": {"0": [[1, 0]], "1": [[0, 0]]},
+		"bowserCoins": 5,
+		"toggles": [false, true]
+	}`)
+	expected := []Chain{
+		{{Type: Start}, {Type: Blue, HiddenBlock: true}, {Type: BogusItem}},
+		{{Type: Star}},
+	}
+	if !reflect.DeepEqual(expected, *b.Chains) {
+		t.Errorf("Expected chains: %#v, got: %#v", expected, *b.Chains)
+	}
+	expectedLinks := map[int]*[]ChainSpace{
+		0: {{1, 0}},
+		1: {{0, 0}},
+	}
+	if !reflect.DeepEqual(expectedLinks, *b.Links) {
+		t.Errorf("Expected links: %#v, got: %#v", expectedLinks, *b.Links)
+	}
+	IntIs(5, b.BowserCoins, "BowserCoins", t)
+	bd := b.Data.(GenericBoardData)
+	if bd.Toggles[0] || !bd.Toggles[1] {
+		t.Errorf("Unexpected toggles: %v", bd.Toggles)
+	}
+}
+
+func TestLoadBoardErrors(t *testing.T) {
+	tests := map[string]string{
+		"No chains":    `{"chains": []}`,
+		"Empty chain":  `{"chains": [{"spaces": []}]}`,
+		"Unknown type": `{"chains": [{"spaces": [{"type": "Purple"}]}]}`,
+		"Unknown field": `{"chains": [{"spaces": [{"type": "Blue"}]}],
+			"gates": 2}`,
+		"Stop on Blue": `{"chains": [{"spaces": [{"type": "Blue",
+			"stop": [{"behavior": "toggle"}]}]}]}`,
+		"Stop on Invisible": `{"chains": [{"spaces": [
+			{"type": "Start"},
+			{"type": "Blue"},
+			{"type": "Invisible", "stop": [{"behavior": "toggle"}]},
+			{"type": "Blue"}]}],
+			"toggles": [false]}`,
+		"Pass on Happening": `{"chains": [{"spaces": [{"type": "Happening",
+			"pass": [{"behavior": "toggle"}]}]}]}`,
+		"Unknown behavior": `{"chains": [{"spaces": [{"type": "Happening",
+			"stop": [{"behavior": "explode"}]}]}]}`,
+		"Toggle out of range": `{"chains": [{"spaces": [{"type": "Happening",
+			"stop": [{"behavior": "toggle", "toggle": 8}]}]}]}`,
+		"When out of range": `{"chains": [{"spaces": [{"type": "Happening",
+			"stop": [{"behavior": "toggle",
+				"when": {"toggle": -1, "is": true}}]}]}]}`,
+		"Warp without dest": `{"chains": [{"spaces": [{"type": "Invisible",
+			"pass": [{"behavior": "warp"}]}]}]}`,
+		"Warp to unknown space": `{"chains": [{"spaces": [{"type": "Invisible",
+			"pass": [{"behavior": "warp", "dest": [0, 1]}]}]}]}`,
+		"Link from unknown chain": `{"chains": [{"spaces": [{"type": "Blue"}]}],
+			"links": {"1": [[0, 0]]}}`,
+		"Link to unknown space": `{"chains": [{"spaces": [{"type": "Blue"}]}],
+			"links": {"0": [[1, 0]]}}`,
+		"Too many toggles": `{"chains": [{"spaces": [{"type": "Blue"}]}],
+			"toggles": [false, false, false, false, false, false, false,
+				false, false]}`,
+	}
+	for name, s := range tests {
+		if _, err := LoadBoard(strings.NewReader(s)); err == nil {
+			t.Errorf("%s: Expected error", name)
+		}
+	}
+}
+
+func TestLoadBoardExits(t *testing.T) {
+	b := LoadBoardString(t, `{
+		"chains": [
+			{"spaces": [
+				{"type": "Start"},
+				{"type": "Invisible", "pass": [
+					{"behavior": "warp", "dest": [1, 0],
+						"when": {"toggle": 0, "is": true}}
+				]},
+				{"type": "Invisible", "pass": [{"behavior": "warp", "dest": [0, 0]}]}
+			]},
+			{"spaces": [
+				{"type": "Invisible"},
+				{"type": "Happening", "stop": [{"behavior": "warp", "dest": [0, 0]}]}
+			]}
+		],
+		"links": {"1": [[0, 0]]}
+	}`)
+	if errs := ValidateBoard(b); errs != nil {
+		t.Errorf("Expected no errors, got: %v", errs)
+	}
+	chains := *b.Chains
+	expected := []Exit{{Dest: ChainSpace{1, 0}}, {Dest: ChainSpace{0, 1}}}
+	if !reflect.DeepEqual(expected, chains[0][1].Exits) {
+		t.Errorf("Expected Exits: %v, got: %v", expected, chains[0][1].Exits)
+	}
+	if len(chains[1][1].Exits) != 1 {
+		t.Errorf("Expected stop warp Exit, got: %v", chains[1][1].Exits)
+	}
+}
+
+func TestBoardFileToll(t *testing.T) {
+	b := LoadBoardString(t, `{
+		"chains": [{"spaces": [
+			{"type": "Start"},
+			{"type": "Invisible", "pass": [{"behavior": "coins", "coins": -5}]},
+			{"type": "Blue"},
+			{"type": "Blue"}
+		]}],
+		"links": {"0": [[0, 0]]}
+	}`)
+	g := InitializeGame(b, GameConfig{MaxTurns: 20})
+	g.MovePlayer(0, 2)
+	SpaceIs(ChainSpace{0, 3}, 0, *g, "", t)
+	CoinsIs(8, 0, *g, "", t)
+}
+
+func TestBoardFileWarp(t *testing.T) {
+	b := LoadBoardString(t, `{
+		"chains": [
+			{"spaces": [
+				{"type": "Start"},
+				{"type": "Invisible", "pass": [{"behavior": "warp", "dest": [1, 0]}]},
+				{"type": "Red"}
+			]},
+			{"spaces": [{"type": "Invisible"}, {"type": "Blue"}]}
+		],
+		"links": {"0": [[0, 0]], "1": [[0, 0]]}
+	}`)
+	g := InitializeGame(b, GameConfig{MaxTurns: 20})
+	g.MovePlayer(0, 1)
+	SpaceIs(ChainSpace{1, 1}, 0, *g, "", t)
+	CoinsIs(13, 0, *g, "", t)
+}
+
+func TestBoardFileCondition(t *testing.T) {
+	b := LoadBoardString(t, `{
+		"chains": [{"spaces": [
+			{"type": "Start"},
+			{"type": "Happening", "stop": [
+				{"behavior": "toggle", "toggle": 1},
+				{"behavior": "coins", "coins": 5, "when": {"toggle": 1, "is": true}}
+			]}
+		]}],
+		"links": {"0": [[0, 0]]}
+	}`)
+	g := InitializeGame(b, GameConfig{MaxTurns: 20, NoKoopa: true})
+	g.MovePlayer(0, 1)
+	CoinsIs(15, 0, *g, "Toggled on", t)
+	g.MovePlayer(0, 2)
+	CoinsIs(15, 0, *g, "Toggled off", t)
+	if g.Board.Data.(GenericBoardData).Toggles[1] {
+		t.Errorf("Expected toggle 1 to be off")
+	}
+}
+
+func TestBoardFileBuyStar(t *testing.T) {
+	b := LoadBoardString(t, `{
+		"chains": [{"spaces": [
+			{"type": "Start"},
+			{"type": "Happening", "stop": [{"behavior": "buyStar", "coins": 15}]}
+		]}],
+		"links": {"0": [[0, 0]]}
+	}`)
+	g := InitializeGame(b, GameConfig{MaxTurns: 20, NoKoopa: true})
+	g.MovePlayer(0, 1)
+	StarsIs(0, 0, *g, "Too poor", t)
+	g.Players[0].Coins = 16
+	g.MovePlayer(0, 2)
+	StarsIs(1, 0, *g, "Bought", t)
+	CoinsIs(1, 0, *g, "Bought", t)
+}
+
+func TestBoardFileStarSwap(t *testing.T) {
+	b := LoadBoardString(t, `{
+		"chains": [{"spaces": [
+			{"type": "Start"},
+			{"type": "Happening", "stop": [{"behavior": "starSwap"}]},
+			{"type": "Star"},
+			{"type": "Star"}
+		]}],
+		"links": {"0": [[0, 0]]}
+	}`)
+	g := InitializeGame(b, GameConfig{MaxTurns: 20})
+	g.StarSpaces.CurrentStarSpace = ChainSpace{0, 2}
+	g.MovePlayer(0, 1)
+	if g.StarSpaces.CurrentStarSpace != (ChainSpace{0, 3}) {
+		t.Errorf("Expected star at %v, got: %v", ChainSpace{0, 3},
+			g.StarSpaces.CurrentStarSpace)
+	}
+}
+
+func TestRegisterBehavior(t *testing.T) {
+	RegisterBehavior("testDouble", func(g *Game, player, moves int, spec BehaviorSpec) int {
+		g.AwardCoins(player, g.Players[player].Coins, false)
+		return moves
+	})
+	defer delete(behaviors, "testDouble")
+	b := LoadBoardString(t, `{
+		"chains": [{"spaces": [
+			{"type": "Start"},
+			{"type": "Happening", "stop": [{"behavior": "testDouble"}]}
+		]}],
+		"links": {"0": [[0, 0]]}
+	}`)
+	g := InitializeGame(b, GameConfig{MaxTurns: 20})
+	g.MovePlayer(0, 1)
+	CoinsIs(20, 0, *g, "", t)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected duplicate registration to panic")
+		}
+	}()
+	RegisterBehavior("toggle", toggleBehavior)
+}
+
+func TestSaveLoadBoardFile(t *testing.T) {
+	b := LoadBoardString(t, `{
+		"chains": [{"spaces": [
+			{"type": "Start"},
+			{"type": "Happening", "stop": [{"behavior": "toggle", "toggle": 2}]}
+		]}],
+		"links": {"0": [[0, 0]]}
+	}`)
+	RegisterBoard("test-boardfile", b)
+	defer delete(boardRegistry, "test-boardfile")
+	g := InitializeGame(b, GameConfig{MaxTurns: 20})
+	g.MovePlayer(0, 1)
+
+	loaded := SaveAndLoad(g, t)
+	if !loaded.Board.Data.(GenericBoardData).Toggles[2] {
+		t.Errorf("Expected toggle 2 to survive loading")
+	}
+	loaded.MovePlayer(0, 2)
+	if loaded.Board.Data.(GenericBoardData).Toggles[2] {
+		t.Errorf("Expected loaded board to keep its behaviors")
+	}
+}
@@ -13,7 +13,7 @@ func (g *Game) PreBowserCheck(player int) {
 	if g.Players[player].Coins == 0 {
 		if g.Players[player].Stars > 0 {
 			g.AwardCoins(player, 10, false)
-			g.Players[player].Stars--
+			g.AwardStars(player, -1)
 		} else {
 			g.AwardCoins(player, 20, false)
 		}
@@ -65,6 +65,28 @@ func (b BowserResponse) String() string {
 	return ""
 }
 
+func (b BowserResponse) Key() string {
+	switch b {
+	case CoinsForBowser:
+		return "CoinsForBowser"
+	case BowserBalloonBurst:
+		return "BowserBalloonBurst"
+	case BowsersFaceLift:
+		return "BowsersFaceLift"
+	case BowsersTugoWar:
+		return "BowsersTugoWar"
+	case BashnCash:
+		return "BashnCash"
+	case BowserRevolution:
+		return "BowserRevolution"
+	case BowsersChanceTime:
+		return "BowsersChanceTime"
+	case StarPresent:
+		return "StarPresent"
+	}
+	return ""
+}
+
 func (b BowserEvent) Question(g *Game) string {
 	return "What did Bowser pick as the punishment?"
 }
@@ -73,18 +95,28 @@ func (b BowserEvent) Type() EventType {
 	return ENUM_EVT_TYPE
 }
 
+var bowserResponses = []Response{
+	CoinsForBowser,
+	BowserBalloonBurst,
+	BowsersFaceLift,
+	BowsersTugoWar,
+	BashnCash,
+	BowserRevolution,
+	BowsersChanceTime,
+	StarPresent,
+}
+
 //Responses returns a slice of all of Bowser's actions.
 func (b BowserEvent) Responses() []Response {
-	return []Response{
-		CoinsForBowser,
-		BowserBalloonBurst,
-		BowsersFaceLift,
-		BowsersTugoWar,
-		BashnCash,
-		BowserRevolution,
-		BowsersChanceTime,
-		StarPresent,
-	}
+	return responses(b)
+}
+
+func (b BowserEvent) ResponseCount() int {
+	return len(bowserResponses)
+}
+
+func (b BowserEvent) ResponseAt(i int) Response {
+	return bowserResponses[i]
 }
 
 func (b BowserEvent) ControllingPlayer() int {
@@ -92,9 +124,14 @@ func (b BowserEvent) ControllingPlayer() int {
 }
 
 //Handle takes the action r and executes it, setting a new event if needed.
+//The effect of StarPresent is not modelled, it only ends the turn.
 func (b BowserEvent) Handle(r Response, g *Game) {
 	choice := r.(BowserResponse)
 	switch choice {
+	case BowserBalloonBurst, BowsersFaceLift, BowsersTugoWar, BashnCash:
+		g.startMinigame(choice)
+	}
+	switch choice {
 	case CoinsForBowser:
 		coinsLost := GetBowserMinigameCoinLoss(g.Turn)
 		g.AwardCoins(b.Player, -coinsLost, false)
@@ -114,11 +151,13 @@ func (b BowserEvent) Handle(r Response, g *Game) {
 		}
 		coins /= 4
 		for i := range g.Players {
-			g.Players[i].Coins = coins
+			g.setCoins(i, coins)
 		}
 		g.EndCharacterTurn()
 	case BowsersChanceTime:
 		g.NextEvent = BowsersChanceTimeEvent{}
+	case StarPresent:
+		g.EndCharacterTurn()
 	}
 }
 
@@ -193,6 +232,7 @@ func (b BowsersFaceLiftEvent) Handle(r Response, g *Game) {
 	results := r.(int)
 	if results == 15 { //All players won
 		g.AwardCoins(b.Player, -50, true)
+		g.EndCharacterTurn()
 		return
 	}
 
@@ -232,6 +272,18 @@ func (b BowsersTugoWarResult) String() string {
 	return ""
 }
 
+func (b BowsersTugoWarResult) Key() string {
+	switch b {
+	case BTWDraw:
+		return "BTWDraw"
+	case BTW1TWin:
+		return "BTW1TWin"
+	case BTW3TWin:
+		return "BTW3TWin"
+	}
+	return ""
+}
+
 var BTWResults = []Response{
 	BTWDraw,
 	BTW1TWin,
@@ -248,7 +300,15 @@ func (b BowsersTugoWarEvent) Type() EventType {
 
 //Responses returns a slice of the valid end results of Bowser's Tug o War.
 func (b BowsersTugoWarEvent) Responses() []Response {
-	return BTWResults
+	return responses(b)
+}
+
+func (b BowsersTugoWarEvent) ResponseCount() int {
+	return len(BTWResults)
+}
+
+func (b BowsersTugoWarEvent) ResponseAt(i int) Response {
+	return BTWResults[i]
 }
 
 func (b BowsersTugoWarEvent) ControllingPlayer() int {
@@ -355,7 +415,15 @@ func (b BowsersChanceTimeEvent) Type() EventType {
 
 //Responses return the valid responses to Bowser's Chance Time Event.
 func (b BowsersChanceTimeEvent) Responses() []Response {
-	return BCTResponses
+	return responses(b)
+}
+
+func (b BowsersChanceTimeEvent) ResponseCount() int {
+	return len(BCTResponses)
+}
+
+func (b BowsersChanceTimeEvent) ResponseAt(i int) Response {
+	return BCTResponses[i]
 }
 
 func (b BowsersChanceTimeEvent) ControllingPlayer() int {
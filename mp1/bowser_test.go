@@ -69,12 +69,25 @@ func TestBowsersFaceLift(t *testing.T) {
 	gDraw := g
 	gDraw.NextEvent.Handle(0b1111, &gDraw) //Draw
 	CoinsIs(0, 0, gDraw, "Draw", t)
+	EventIs(NormalDiceBlock{Range{1, 10}, 1}, gDraw.NextEvent, "Draw", t)
 
 	gP1Loss := g
 	gP1Loss.NextEvent.Handle(0b1110, &gP1Loss) //All players except Daisy
 	CoinsIs(40, 0, gP1Loss, "P1Loss", t)
 }
 
+func TestStarPresent(t *testing.T) {
+	g := *InitializeGame(BowserBoard, GameConfig{MaxTurns: 20})
+	g.Players[0].Coins = 50
+	g.Players[0].Stars = 2
+
+	g.MovePlayer(0, 1)
+	g.NextEvent.Handle(StarPresent, &g)
+	StarsIs(2, 0, g, "", t)
+	CoinsIs(50, 0, g, "", t)
+	EventIs(NormalDiceBlock{Range{1, 10}, 1}, g.NextEvent, "", t)
+}
+
 func TestBowsersTugoWar(t *testing.T) {
 	g := *InitializeGame(BowserBoard, GameConfig{MaxTurns: 20})
 	g.Players[0].Coins = 50
@@ -104,29 +104,73 @@ func (c ChanceTime) Type() EventType {
 //Responses returns a slice of the remaining blocks and block positions
 //that can be chosen during chance time.
 func (c ChanceTime) Responses() []Response {
-	res := []Response{}
+	return responses(c)
+}
+
+func (c ChanceTime) ResponseCount() int {
+	left, middle, right := c.blockCounts()
+	return left + middle + right
+}
+
+//ResponseAt returns the ith response: the left block's players, then the
+//middle block's actions, then the right block's players. A side block
+//cannot land on the player the other side block landed on.
+func (c ChanceTime) ResponseAt(i int) Response {
+	left, middle, right := c.blockCounts()
+	switch {
+	case i < 0:
+	case i < left:
+		if c.RightSideHit && i >= c.RightSidePosition {
+			i++
+		}
+		return chanceTimeResponses[CTBLeft][i]
+	case i < left+middle:
+		return chanceTimeResponses[CTBMiddle][i-left]
+	case i < left+middle+right:
+		i -= left + middle
+		if c.LeftSideHit && i >= c.LeftSidePosition {
+			i++
+		}
+		return chanceTimeResponses[CTBRight][i]
+	}
+	panic("mp1: ChanceTime response index out of range")
+}
+
+//chanceTimeResponses holds every ChanceTimeResponse by block and
+//position, so ResponseAt does not allocate.
+var chanceTimeResponses = func() (ret [3][]Response) {
+	for block := range ret {
+		count := 4
+		if ChanceTimeBlock(block) == CTBMiddle {
+			count = int(CMBCount)
+		}
+		for i := 0; i < count; i++ {
+			ret[block] = append(ret[block],
+				ChanceTimeResponse{ChanceTimeBlock(block), i})
+		}
+	}
+	return ret
+}()
+
+//blockCounts returns the number of responses of each block that has not
+//been hit yet.
+func (c ChanceTime) blockCounts() (left, middle, right int) {
 	if !c.LeftSideHit {
-		for i := 0; i < 4; i++ {
-			if c.RightSideHit && c.RightSidePosition == i {
-				continue
-			}
-			res = append(res, ChanceTimeResponse{CTBLeft, i})
+		left = 4
+		if c.RightSideHit {
+			left--
 		}
 	}
 	if !c.MiddleHit {
-		for i := 0; i < int(CMBCount); i++ {
-			res = append(res, ChanceTimeResponse{CTBMiddle, i})
-		}
+		middle = int(CMBCount)
 	}
 	if !c.RightSideHit {
-		for i := 0; i < 4; i++ {
-			if c.LeftSideHit && c.LeftSidePosition == i {
-				continue
-			}
-			res = append(res, ChanceTimeResponse{CTBRight, i})
+		right = 4
+		if c.LeftSideHit {
+			right--
 		}
 	}
-	return res
+	return left, middle, right
 }
 
 func (c ChanceTime) ControllingPlayer() int {
@@ -176,8 +220,8 @@ func (c ChanceTime) Handle(r Response, g *Game) {
 			g.GiveCoins(c.LeftSidePosition, c.RightSidePosition, 30, false)
 		case LTRStar:
 			if g.Players[c.LeftSidePosition].Stars > 0 {
-				g.Players[c.LeftSidePosition].Stars--
-				g.Players[c.RightSidePosition].Stars++
+				g.AwardStars(c.LeftSidePosition, -1)
+				g.AwardStars(c.RightSidePosition, 1)
 			}
 		case RTL10:
 			g.GiveCoins(c.RightSidePosition, c.LeftSidePosition, 10, false)
@@ -186,18 +230,19 @@ func (c ChanceTime) Handle(r Response, g *Game) {
 		case RTL30:
 			g.GiveCoins(c.RightSidePosition, c.LeftSidePosition, 30, false)
 		case RTLStar:
-			if g.Players[c.LeftSidePosition].Stars > 0 {
-				g.Players[c.LeftSidePosition].Stars++
-				g.Players[c.RightSidePosition].Stars--
+			if g.Players[c.RightSidePosition].Stars > 0 {
+				g.AwardStars(c.LeftSidePosition, 1)
+				g.AwardStars(c.RightSidePosition, -1)
 			}
 		case SwapCoins:
 			tmp := g.Players[c.LeftSidePosition].Coins
-			g.Players[c.LeftSidePosition].Coins = g.Players[c.RightSidePosition].Coins
-			g.Players[c.RightSidePosition].Coins = tmp
+			g.setCoins(c.LeftSidePosition, g.Players[c.RightSidePosition].Coins)
+			g.setCoins(c.RightSidePosition, tmp)
 		case SwapStars:
-			tmp := g.Players[c.LeftSidePosition].Stars
-			g.Players[c.LeftSidePosition].Stars = g.Players[c.RightSidePosition].Stars
-			g.Players[c.RightSidePosition].Stars = tmp
+			diff := g.Players[c.RightSidePosition].Stars -
+				g.Players[c.LeftSidePosition].Stars
+			g.AwardStars(c.LeftSidePosition, diff)
+			g.AwardStars(c.RightSidePosition, -diff)
 		}
 		g.EndCharacterTurn()
 	} else {
@@ -38,6 +38,19 @@ func TestChanceTime(t *testing.T) {
 	StarsIs(5, 0, gStar, "LTR", t)
 	StarsIs(8, 1, gStar, "LTR", t)
 
+	gRTLStar := g
+	gRTLStar.NextEvent.Handle(ChanceTimeResponse{CTBMiddle, int(RTLStar)}, &gRTLStar)
+	StarsIs(3, 0, gRTLStar, "RTL", t)
+	StarsIs(10, 1, gRTLStar, "RTL", t)
+
+	//The right side player gives the star, so nothing happens when they
+	//have none
+	gRTLNoStar := g
+	gRTLNoStar.Players[0].Stars = 0
+	gRTLNoStar.NextEvent.Handle(ChanceTimeResponse{CTBMiddle, int(RTLStar)}, &gRTLNoStar)
+	StarsIs(0, 0, gRTLNoStar, "RTL No Star", t)
+	StarsIs(9, 1, gRTLNoStar, "RTL No Star", t)
+
 	gSwapCoins := g
 	gSwapCoins.NextEvent.Handle(ChanceTimeResponse{CTBMiddle, int(SwapCoins)}, &gSwapCoins)
 	CoinsIs(25, 0, gSwapCoins, "Swap", t)
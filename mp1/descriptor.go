@@ -0,0 +1,199 @@
+package mp1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//EventDescriptor describes an event and its responses with plain values,
+//so a front-end can render any event and submit a response by its ID
+//without knowing the event's Go types.
+type EventDescriptor struct {
+	//Event is the registered name of the event's type.
+	Event             string
+	Question          string
+	ControllingPlayer int
+	//Kind is the name of the event's EventType.
+	Kind string
+	//Range holds the bounds of events built on a Range, and is nil for
+	//every other event.
+	Range *Range `json:",omitempty"`
+	//Players labels the player indices used by player and
+	//multiwin_player responses.
+	Players []PlayerLabel `json:",omitempty"`
+	Options []ResponseOption
+}
+
+//PlayerLabel names a player index.
+type PlayerLabel struct {
+	Index int
+	Name  string
+}
+
+//ResponseOption is a response of an event.
+type ResponseOption struct {
+	//ID identifies the response, see ResponseID.
+	ID    string
+	Label string
+	Value Response
+	//SpaceType is the type of the space of a chainspace response.
+	SpaceType string `json:",omitempty"`
+}
+
+//Describe returns the EventDescriptor of e, the next event of g. Player
+//names are taken from g's characters.
+func Describe(g *Game, e Event) EventDescriptor {
+	d := EventDescriptor{
+		Event:             typeName(e),
+		Question:          e.Question(g),
+		ControllingPlayer: e.ControllingPlayer(),
+		Kind:              e.Type().String(),
+	}
+	if rng, ok := e.(ranged); ok {
+		r := rng.bounds()
+		d.Range = &r
+	}
+	kind := e.Type()
+	if kind == PLAYER_EVT_TYPE || kind == MULTIWIN_PLAYER_EVT_TYPE {
+		for i := range g.Players {
+			d.Players = append(d.Players, PlayerLabel{i, playerName(g, i)})
+		}
+	}
+	n := ResponseCount(e)
+	d.Options = make([]ResponseOption, n)
+	for i := 0; i < n; i++ {
+		r := ResponseAt(e, i)
+		opt := ResponseOption{
+			ID:    ResponseID(r),
+			Label: fmt.Sprint(r),
+			Value: r,
+		}
+		switch v := r.(type) {
+		case int:
+			switch kind {
+			case PLAYER_EVT_TYPE:
+				opt.Label = playerLabel(g, v)
+			case MULTIWIN_PLAYER_EVT_TYPE:
+				opt.Label = winnersLabel(g, v)
+			}
+		case ChainSpace:
+			opt.Label = fmt.Sprintf("Chain %d, Space %d", v.Chain, v.Space)
+			if g.Board.has(v) {
+				opt.SpaceType = (*g.Chains)[v.Chain][v.Space].Type.String()
+			}
+		}
+		d.Options[i] = opt
+	}
+	return d
+}
+
+//ResponseID returns the ID of r: the registered name of its type and its
+//JSON value, such as int:3 or ChainSpace:{"Chain":1,"Space":4}. Keyed
+//enums use their key as a JSON string instead, as in
+//BowserResponse:"StarPresent", so IDs depend neither on the order the
+//constants are declared in nor on their labels. IDs stay the same between
+//games and releases as long as the type names and keys do.
+func ResponseID(r Response) string {
+	return typeName(r) + ":" + string(idValue(r))
+}
+
+//Keyed is implemented by enum responses. Key returns the name of the
+//value's constant, which is kept when String's label is reworded.
+type Keyed interface {
+	Key() string
+}
+
+//idValue returns the JSON value of r used in its ID.
+func idValue(r Response) []byte {
+	if k, ok := r.(Keyed); ok && k.Key() != "" {
+		value, _ := json.Marshal(k.Key())
+		return value
+	}
+	value, err := json.Marshal(r)
+	if err != nil {
+		value = []byte(fmt.Sprintf("%#v", r))
+	}
+	return value
+}
+
+//ResponseByID returns the response of e whose ResponseID is id, or false
+//if e has no such response.
+func ResponseByID(e Event, id string) (Response, bool) {
+	//Registered responses are decoded from their ID, others, including
+	//enums identified by key, are searched for.
+	if i := strings.IndexByte(id, ':'); i >= 0 {
+		name, value := id[:i], json.RawMessage(id[i+1:])
+		if _, ok := typeRegistry[name]; ok {
+			v, err := unmarshalTyped(&typedValue{name, value})
+			if err == nil {
+				if ResponseID(v) != id || !ValidResponse(e, v) {
+					return nil, false
+				}
+				return v, true
+			}
+		}
+	}
+	for i, n := 0, ResponseCount(e); i < n; i++ {
+		if r := ResponseAt(e, i); ResponseID(r) == id {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+//ApplyID applies the response of the next event whose ResponseID is id,
+//see Apply.
+func (g *Game) ApplyID(id string) error {
+	if g.NextEvent == nil {
+		return ErrNoPendingEvent
+	}
+	r, ok := ResponseByID(g.NextEvent, id)
+	if !ok {
+		return &InvalidResponseError{g.NextEvent, id}
+	}
+	return g.Apply(r)
+}
+
+//typeName returns the registered name of v's type, or its Go type.
+func typeName(v interface{}) string {
+	if name, ok := RegisteredName(v); ok {
+		return name
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+//playerName returns the character of player, or "Player n" if it has
+//none.
+func playerName(g *Game, player int) string {
+	if c := g.Players[player].Char; c != "" {
+		return c
+	}
+	return "Player " + strconv.Itoa(player+1)
+}
+
+//playerLabel labels the response of a player event.
+func playerLabel(g *Game, player int) string {
+	if player >= 0 && player < len(g.Players) {
+		return playerName(g, player)
+	}
+	if player == len(g.Players) {
+		return "Draw"
+	}
+	return strconv.Itoa(player)
+}
+
+//winnersLabel labels the player mask of a multiwin event.
+func winnersLabel(g *Game, mask int) string {
+	var names []string
+	for i := range g.Players {
+		if mask&(1<<i) != 0 {
+			names = append(names, playerName(g, i))
+		}
+	}
+	if len(names) == 0 {
+		return "Nobody"
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,225 @@
+package mp1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestEventTypeString(t *testing.T) {
+	names := map[EventType]string{
+		ENUM_EVT_TYPE:            "enum",
+		RANGE_EVT_TYPE:           "range",
+		COIN_EVT_TYPE:            "coin",
+		PLAYER_EVT_TYPE:          "player",
+		MULTIWIN_PLAYER_EVT_TYPE: "multiwin_player",
+		CHAINSPACE_EVT_TYPE:      "chainspace",
+	}
+	for typ, expected := range names {
+		if got := typ.String(); got != expected {
+			t.Errorf("Expected %s, got: %s", expected, got)
+		}
+	}
+}
+
+func TestResponseID(t *testing.T) {
+	tests := []struct {
+		r        Response
+		expected string
+	}{
+		{3, "int:3"},
+		{-10, "int:-10"},
+		{ChainSpace{1, 4}, `ChainSpace:{"Chain":1,"Space":4}`},
+		{StarPresent, `BowserResponse:"StarPresent"`},
+		{BowserResponse(99), "BowserResponse:99"},
+		{BCTResponse{2, 30}, `BCTResponse:{"Player":2,"Coins":30}`},
+	}
+	for _, tt := range tests {
+		if got := ResponseID(tt.r); got != tt.expected {
+			t.Errorf("Expected ID of %#v: %s, got: %s", tt.r, tt.expected, got)
+		}
+	}
+}
+
+//EnumKeyed checks that every labelled value of the enum type rt has a
+//key, and that no two values share one.
+func EnumKeyed(rt reflect.Type, flavour string, t *testing.T) {
+	t.Helper()
+	if !rt.Implements(reflect.TypeOf((*Keyed)(nil)).Elem()) {
+		t.Errorf("%s: %v is not Keyed", flavour, rt)
+		return
+	}
+	seen := map[string]bool{}
+	for i := 0; ; i++ {
+		v := reflect.New(rt).Elem()
+		v.SetInt(int64(i))
+		if fmt.Sprint(v.Interface()) == "" {
+			break
+		}
+		key := v.Interface().(Keyed).Key()
+		if key == "" || seen[key] {
+			t.Errorf("%s: %v has a missing or repeated key %q",
+				flavour, v.Interface(), key)
+		}
+		seen[key] = true
+	}
+}
+
+func TestRegisteredEnumsKeyed(t *testing.T) {
+	for name, rt := range typeRegistry {
+		if rt.Kind() == reflect.Int && name != "int" {
+			EnumKeyed(rt, name, t)
+		}
+	}
+}
+
+func TestResponseByID(t *testing.T) {
+	e := NormalDiceBlock{Range{1, 10}, 0}
+	tests := []struct {
+		id    string
+		r     Response
+		found bool
+	}{
+		{"int:4", 4, true},
+		{"int:11", nil, false},
+		{"int: 4", nil, false},
+		{"int:x", nil, false},
+		{"BowserResponse:4", nil, false},
+		{`BowserResponse:"StarPresent"`, nil, false},
+		{"Nope:4", nil, false},
+		{"4", nil, false},
+	}
+	for _, tt := range tests {
+		r, found := ResponseByID(e, tt.id)
+		if r != tt.r || found != tt.found {
+			t.Errorf("Expected %s to be %v, %v, got: %v, %v",
+				tt.id, tt.r, tt.found, r, found)
+		}
+	}
+}
+
+func TestDescribeEnum(t *testing.T) {
+	g := InitializeGame(BowserBoard, GameConfig{MaxTurns: 20})
+	d := Describe(g, BowserEvent{0})
+	if d.Event != "BowserEvent" || d.Kind != "enum" ||
+		d.ControllingPlayer != CPU_PLAYER || d.Range != nil || d.Players != nil {
+		t.Errorf("Unexpected descriptor: %#v", d)
+	}
+	if len(d.Options) != len(bowserResponses) {
+		t.Fatalf("Expected %d options, got: %d",
+			len(bowserResponses), len(d.Options))
+	}
+	expected := ResponseOption{
+		ID:    `BowserResponse:"StarPresent"`,
+		Label: "Star Present",
+		Value: StarPresent,
+	}
+	if d.Options[7] != expected {
+		t.Errorf("Expected %#v, got: %#v", expected, d.Options[7])
+	}
+}
+
+func TestDescribeRange(t *testing.T) {
+	g := InitializeGame(BowserBoard, GameConfig{MaxTurns: 20})
+	d := Describe(g, NormalDiceBlock{Range{1, 10}, 0})
+	if d.Kind != "range" || d.Range == nil || *d.Range != (Range{1, 10}) {
+		t.Errorf("Expected range [1, 10], got: %#v", d)
+	}
+	if len(d.Options) != 10 || d.Options[0].ID != "int:1" ||
+		d.Options[0].Label != "1" {
+		t.Errorf("Unexpected options: %#v", d.Options)
+	}
+}
+
+func TestDescribePlayers(t *testing.T) {
+	g := InitializeGame(BowserBoard, GameConfig{MaxTurns: 20})
+	g.Players[0].Char = "Mario"
+	g.Players[2].Char = "Peach"
+	expected := []PlayerLabel{
+		{0, "Mario"}, {1, "Player 2"}, {2, "Peach"}, {3, "Player 4"},
+	}
+
+	d := Describe(g, BowserBalloonBurstEvent{Range{0, 4}})
+	if d.Kind != "player" || !reflect.DeepEqual(d.Players, expected) {
+		t.Errorf("Expected players %v, got: %#v", expected, d)
+	}
+	labels := []string{"Mario", "Player 2", "Peach", "Player 4", "Draw"}
+	for i, opt := range d.Options {
+		if opt.Label != labels[i] {
+			t.Errorf("Expected label %s, got: %s", labels[i], opt.Label)
+		}
+	}
+
+	d = Describe(g, BowsersFaceLiftEvent{Range{0, 15}, 0})
+	if d.Kind != "multiwin_player" || len(d.Players) != 4 {
+		t.Errorf("Expected multiwin players, got: %#v", d)
+	}
+	if d.Options[0].Label != "Nobody" ||
+		d.Options[0b0101].Label != "Mario, Peach" {
+		t.Errorf("Unexpected options: %#v", d.Options)
+	}
+}
+
+func TestDescribeChainSpace(t *testing.T) {
+	g := InitializeGame(partyBoard, GameConfig{MaxTurns: 20})
+	d := Describe(g, BranchEvent{0, 3, (*partyBoard.Links)[0]})
+	expected := []ResponseOption{
+		{`ChainSpace:{"Chain":0,"Space":0}`, "Chain 0, Space 0",
+			ChainSpace{0, 0}, "Start"},
+		{`ChainSpace:{"Chain":1,"Space":0}`, "Chain 1, Space 0",
+			ChainSpace{1, 0}, "Blue"},
+	}
+	if d.Kind != "chainspace" || !reflect.DeepEqual(d.Options, expected) {
+		t.Errorf("Expected %#v, got: %#v", expected, d.Options)
+	}
+}
+
+func TestDescribeJSON(t *testing.T) {
+	g := InitializeGame(BowserBoard, GameConfig{MaxTurns: 20})
+	data, err := json.Marshal(Describe(g, BowsersChanceTimeEvent{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var d struct {
+		Kind    string
+		Options []struct{ ID, Label string }
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		t.Fatal(err)
+	}
+	id := `BCTResponse:{"Player":0,"Coins":20}`
+	if d.Kind != "enum" || d.Options[1].ID != id ||
+		d.Options[1].Label != "Player 1 loses 20 coins" {
+		t.Errorf("Unexpected JSON: %s", data)
+	}
+}
+
+func TestApplyID(t *testing.T) {
+	g := InitializeGame(BowserBoard, GameConfig{MaxTurns: 20})
+	g.Players[0].Coins = 30
+	g.MovePlayer(0, 1)
+	before := g.Clone()
+	err := g.ApplyID("BowserResponse:99")
+	var invalid *InvalidResponseError
+	if !errors.As(err, &invalid) || invalid.Response != "BowserResponse:99" {
+		t.Errorf("Expected InvalidResponseError, got: %v", err)
+	}
+	if !reflect.DeepEqual(before, g) {
+		t.Fatal("Expected game to be untouched by an invalid ID")
+	}
+
+	if err := g.ApplyID("BowserResponse:0"); err == nil {
+		t.Fatal("Expected the value of a named response to be invalid")
+	}
+	if err := g.ApplyID(`BowserResponse:"CoinsForBowser"`); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	CoinsIs(20, 0, *g, "Coins For Bowser", t)
+
+	g.NextEvent = nil
+	if err := g.ApplyID("int:1"); err != ErrNoPendingEvent {
+		t.Errorf("Expected ErrNoPendingEvent, got: %v", err)
+	}
+}
@@ -107,6 +107,20 @@ func (w WarpDiceBlock) Responses() []Response {
 	return res[:]
 }
 
+func (w WarpDiceBlock) ResponseCount() int {
+	return 3
+}
+
+func (w WarpDiceBlock) ResponseAt(i int) Response {
+	if i < 0 || i >= 3 {
+		panic("mp1: WarpDiceBlock response index out of range")
+	}
+	if i >= w.Player {
+		i++
+	}
+	return i
+}
+
 func (w WarpDiceBlock) ControllingPlayer() int {
 	return CPU_PLAYER
 }
@@ -149,6 +163,18 @@ func (e EventBlockEvent) String() string {
 	return ""
 }
 
+func (e EventBlockEvent) Key() string {
+	switch e {
+	case BooEventBlock:
+		return "BooEventBlock"
+	case BowserEventBlock:
+		return "BowserEventBlock"
+	case KoopaEventBlock:
+		return "KoopaEventBlock"
+	}
+	return ""
+}
+
 var EventBlockResponses = []Response{
 	BooEventBlock,
 	BowserEventBlock,
@@ -169,7 +195,15 @@ func (e EventDiceBlock) Type() EventType {
 
 //Responses returns a slice of the possible event dice block actions.
 func (e EventDiceBlock) Responses() []Response {
-	return EventBlockResponses
+	return responses(e)
+}
+
+func (e EventDiceBlock) ResponseCount() int {
+	return len(EventBlockResponses)
+}
+
+func (e EventDiceBlock) ResponseAt(i int) Response {
+	return EventBlockResponses[i]
 }
 
 func (e EventDiceBlock) ControllingPlayer() int {
@@ -182,19 +216,26 @@ func (e EventDiceBlock) Handle(r Response, g *Game) {
 	event := r.(EventBlockEvent)
 	switch event {
 	case BooEventBlock:
-		g.NextEvent = BooEvent{
+		booEvt := BooEvent{
 			e.Player,
 			g.Players,
 			0,
 			g.Players[e.Player].Coins,
+			g.Economy().BooStarPrice,
 		}
+		//Nothing to steal, Boo leaves
+		if booEvt.ResponseCount() == 0 {
+			g.EndCharacterTurn()
+			return
+		}
+		g.NextEvent = booEvt
 	case BowserEventBlock:
 		//TODO: Typically bowser just takes 20 coins
 		//Does anything happen if player has 0 coins?
-		g.AwardCoins(e.Player, -20, false)
+		g.AwardCoins(e.Player, -g.Economy().BowserBlockCoins, false)
 		g.EndCharacterTurn()
 	case KoopaEventBlock:
-		g.AwardCoins(e.Player, 10, false)
+		g.AwardCoins(e.Player, g.Economy().KoopaBlockCoins, false)
 		g.EndCharacterTurn()
 	}
 }
@@ -218,20 +259,57 @@ func (p PickDiceBlock) Type() EventType {
 //Responses returns a slice of the available dice blocks that can appear
 //based on the game's configuration.
 func (p PickDiceBlock) Responses() []Response {
-	res := []Response{NormalDiceBlock{Range{1, 10}, p.Player}}
-	if p.Config.RedDice {
-		res = append(res, RedDiceBlock{Range{1, 10}, p.Player})
+	return responses(p)
+}
+
+func (p PickDiceBlock) ResponseCount() int {
+	count := 1
+	for _, on := range p.dice() {
+		if on {
+			count++
+		}
 	}
-	if p.Config.BlueDice {
-		res = append(res, BlueDiceBlock{Range{1, 10}, p.Player})
+	return count
+}
+
+func (p PickDiceBlock) ResponseAt(i int) Response {
+	if i == 0 {
+		return diceBlocks[p.Player][0]
 	}
-	if p.Config.WarpDice {
-		res = append(res, WarpDiceBlock{p.Player})
+	for block, on := range p.dice() {
+		if !on {
+			continue
+		}
+		i--
+		if i == 0 {
+			return diceBlocks[p.Player][block+1]
+		}
+	}
+	panic("mp1: PickDiceBlock response index out of range")
+}
+
+//diceBlocks holds the normal, red, blue, warp and event dice blocks of
+//each player, so ResponseAt does not allocate.
+var diceBlocks = func() (ret [4][5]Response) {
+	for p := range ret {
+		ret[p] = [5]Response{
+			NormalDiceBlock{Range{1, 10}, p},
+			RedDiceBlock{Range{1, 10}, p},
+			BlueDiceBlock{Range{1, 10}, p},
+			WarpDiceBlock{p},
+			EventDiceBlock{p},
+		}
 	}
-	if p.Config.EventsDice {
-		res = append(res, EventDiceBlock{p.Player})
+	return ret
+}()
+
+//dice returns whether the red, blue, warp and event dice blocks are
+//enabled, in that order.
+func (p PickDiceBlock) dice() [4]bool {
+	return [4]bool{
+		p.Config.RedDice, p.Config.BlueDice,
+		p.Config.WarpDice, p.Config.EventsDice,
 	}
-	return res
 }
 
 func (p PickDiceBlock) ControllingPlayer() int {
@@ -40,7 +40,7 @@ func TestEventDiceBlock(t *testing.T) {
 	g.NextEvent = EventDiceBlock{0}
 	gBoo := g
 	gBoo.NextEvent.Handle(BooEventBlock, &gBoo)
-	expectedBooEvent := BooEvent{0, gBoo.Players, 0, gBoo.Players[0].Coins}
+	expectedBooEvent := BooEvent{0, gBoo.Players, 0, gBoo.Players[0].Coins, 50}
 	EventIs(expectedBooEvent, gBoo.NextEvent, "Boo", t)
 
 	gBoo.NextEvent.Handle(BooStealAction{0, 1, false}, &gBoo)
@@ -58,6 +58,16 @@ func TestEventDiceBlock(t *testing.T) {
 	CoinsIs(20, 0, gKoopa, "Koopa", t)
 }
 
+func TestEventDiceBlockBooNoSteal(t *testing.T) {
+	g := *InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	for i := 1; i < 4; i++ {
+		g.Players[i].Coins = 0
+	}
+	g.NextEvent = EventDiceBlock{0}
+	g.NextEvent.Handle(BooEventBlock, &g)
+	EventIs(NormalDiceBlock{Range{1, 10}, 1}, g.NextEvent, "Boo", t)
+}
+
 func TestPickDiceBlock(t *testing.T) {
 	g := *InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20, RedDice: true, BlueDice: true})
 
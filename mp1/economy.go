@@ -0,0 +1,120 @@
+package mp1
+
+//Economy holds the coin amounts used by the rules of the game, so house
+//rules such as cheaper stars can be simulated. Board specific amounts,
+//such as tolls, stay with their board.
+//
+//Bowser's amounts are deliberately left out and keep the values of Mario
+//Party 1: the 10 or 20 coins he gives players with none, the coins lost
+//to Coins For Bowser and his minigames, which grow with the turn, the 20
+//coins lost by everyone in Balloon Burst and the 50 coins of Face Lift.
+type Economy struct {
+	//StarPrice is the cost of a star bought on a star space.
+	StarPrice int
+	//BlueCoins and RedCoins are given and taken by blue and red spaces.
+	//LastTurnsBlueCoins and LastTurnsRedCoins are used instead during the
+	//last five turns.
+	BlueCoins          int
+	RedCoins           int
+	LastTurnsBlueCoins int
+	LastTurnsRedCoins  int
+	//KoopaCoins are given by Koopa to players passing the start space,
+	//KoopaBonusCoins instead on every 10th pass.
+	KoopaCoins      int
+	KoopaBonusCoins int
+	//BooStarPrice is the cost of stealing a star with Boo, and BooMaxCoins
+	//is the most coins Boo steals.
+	BooStarPrice int
+	BooMaxCoins  int
+	//BowserBlockCoins are taken by the Bowser event block, and
+	//KoopaBlockCoins are given by the Koopa event block.
+	BowserBlockCoins int
+	KoopaBlockCoins  int
+	//MinigameCoins are won by the winners of free for all and 2 vs 2
+	//minigames and Pipe Maze, and lost by the losing team of a 2 vs 2
+	//minigame. Other 1 vs 3 and special minigames keep the fixed payouts
+	//of their rules.
+	MinigameCoins int
+}
+
+//DefaultEconomy is the economy of Mario Party 1.
+var DefaultEconomy = Economy{
+	StarPrice:          20,
+	BlueCoins:          3,
+	RedCoins:           3,
+	LastTurnsBlueCoins: 6,
+	LastTurnsRedCoins:  6,
+	KoopaCoins:         10,
+	KoopaBonusCoins:    20,
+	BooStarPrice:       50,
+	BooMaxCoins:        15,
+	BowserBlockCoins:   20,
+	KoopaBlockCoins:    10,
+	MinigameCoins:      10,
+}
+
+//EconomyConfig holds the amounts of a game's Economy changed by its
+//GameConfig. Nil amounts are taken from DefaultEconomy, so a config sets
+//only the amounts it changes, and any amount can be set to 0.
+type EconomyConfig struct {
+	StarPrice          *int `json:",omitempty"`
+	BlueCoins          *int `json:",omitempty"`
+	RedCoins           *int `json:",omitempty"`
+	LastTurnsBlueCoins *int `json:",omitempty"`
+	LastTurnsRedCoins  *int `json:",omitempty"`
+	KoopaCoins         *int `json:",omitempty"`
+	KoopaBonusCoins    *int `json:",omitempty"`
+	BooStarPrice       *int `json:",omitempty"`
+	BooMaxCoins        *int `json:",omitempty"`
+	BowserBlockCoins   *int `json:",omitempty"`
+	KoopaBlockCoins    *int `json:",omitempty"`
+	MinigameCoins      *int `json:",omitempty"`
+}
+
+//Amount returns a pointer to coins, to set an amount of an EconomyConfig.
+func Amount(coins int) *int {
+	return &coins
+}
+
+//Economy returns the economy of g, DefaultEconomy with the amounts set by
+//the GameConfig's Economy.
+func (g *Game) Economy() Economy {
+	c := g.Config.Economy
+	e := DefaultEconomy
+	set := func(amount *int, override *int) {
+		if override != nil {
+			*amount = *override
+		}
+	}
+	set(&e.StarPrice, c.StarPrice)
+	set(&e.BlueCoins, c.BlueCoins)
+	set(&e.RedCoins, c.RedCoins)
+	set(&e.LastTurnsBlueCoins, c.LastTurnsBlueCoins)
+	set(&e.LastTurnsRedCoins, c.LastTurnsRedCoins)
+	set(&e.KoopaCoins, c.KoopaCoins)
+	set(&e.KoopaBonusCoins, c.KoopaBonusCoins)
+	set(&e.BooStarPrice, c.BooStarPrice)
+	set(&e.BooMaxCoins, c.BooMaxCoins)
+	set(&e.BowserBlockCoins, c.BowserBlockCoins)
+	set(&e.KoopaBlockCoins, c.KoopaBlockCoins)
+	set(&e.MinigameCoins, c.MinigameCoins)
+	return e
+}
+
+//BlueSpaceCoins returns the coins given by a blue space this turn.
+func (g *Game) BlueSpaceCoins() int {
+	e := g.Economy()
+	if g.LastFiveTurns() {
+		return e.LastTurnsBlueCoins
+	}
+	return e.BlueCoins
+}
+
+//RedSpaceCoins returns the coins taken by a red space this turn.
+func (g *Game) RedSpaceCoins() int {
+	e := g.Economy()
+	if g.LastFiveTurns() {
+		return e.LastTurnsRedCoins
+	}
+	return e.RedCoins
+}
@@ -0,0 +1,143 @@
+package mp1
+
+import "testing"
+
+//economyBoard is a single chain looping back to its start space.
+var economyBoard = Board{
+	Chains: &[]Chain{
+		{
+			{Type: Start},
+			{Type: Blue},
+			{Type: Red},
+			{Type: Blue},
+		},
+	},
+	Links: &map[int]*[]ChainSpace{0: {NewChainSpace(0, 0)}},
+}
+
+func TestEconomyDefault(t *testing.T) {
+	g := InitializeGame(economyBoard, GameConfig{MaxTurns: 20})
+	if got := g.Economy(); got != DefaultEconomy {
+		t.Errorf("Expected %v, got: %v", DefaultEconomy, got)
+	}
+	IntIs(3, g.BlueSpaceCoins(), "Blue", t)
+	IntIs(3, g.RedSpaceCoins(), "Red", t)
+	g.Turn = 15
+	IntIs(6, g.BlueSpaceCoins(), "Last turns blue", t)
+	IntIs(6, g.RedSpaceCoins(), "Last turns red", t)
+}
+
+func TestEconomyPartial(t *testing.T) {
+	g := InitializeGame(economyBoard, GameConfig{
+		MaxTurns: 20,
+		Economy:  EconomyConfig{StarPrice: Amount(30), RedCoins: Amount(1)},
+	})
+	expected := DefaultEconomy
+	expected.StarPrice = 30
+	expected.RedCoins = 1
+	if got := g.Economy(); got != expected {
+		t.Errorf("Expected %v, got: %v", expected, got)
+	}
+	IntIs(3, g.BlueSpaceCoins(), "Blue", t)
+	IntIs(1, g.RedSpaceCoins(), "Red", t)
+}
+
+func TestEconomyZero(t *testing.T) {
+	g := *InitializeGame(singleStarBoard, GameConfig{
+		MaxTurns: 20,
+		Economy: EconomyConfig{
+			StarPrice: Amount(0),
+			BlueCoins: Amount(0),
+		},
+	})
+	g.Players[0].Coins = 0
+	g.NextEvent.Handle(2, &g) //Buy a free star, land on blue
+	StarsIs(1, 0, g, "Free star", t)
+	CoinsIs(0, 0, g, "Free star", t)
+}
+
+func TestEconomyConfigEqual(t *testing.T) {
+	free := func() GameConfig {
+		return GameConfig{
+			MaxTurns: 20,
+			Economy:  EconomyConfig{StarPrice: Amount(0)},
+		}
+	}
+	a, b := free(), free()
+	if !a.Equal(b) {
+		t.Errorf("Expected configs with the same amounts to be equal")
+	}
+	if a.Equal(GameConfig{MaxTurns: 20}) {
+		t.Errorf("Expected a free star to differ from the default price")
+	}
+}
+
+func TestEconomySpaces(t *testing.T) {
+	economy := EconomyConfig{BlueCoins: Amount(5), RedCoins: Amount(1)}
+	g := *InitializeGame(economyBoard, GameConfig{MaxTurns: 20, Economy: economy})
+	g.NextEvent.Handle(1, &g)
+	CoinsIs(15, 0, g, "Blue", t)
+	g.NextEvent.Handle(2, &g)
+	CoinsIs(9, 1, g, "Red", t)
+}
+
+func TestEconomyStarPrice(t *testing.T) {
+	economy := EconomyConfig{StarPrice: Amount(10)}
+	g := *InitializeGame(singleStarBoard, GameConfig{
+		MaxTurns: 20,
+		Economy:  economy,
+	})
+	g.NextEvent.Handle(2, &g) //Buy a star for 10 coins, land on blue
+	StarsIs(1, 0, g, "Star", t)
+	CoinsIs(3, 0, g, "Star", t)
+}
+
+func TestEconomyKoopa(t *testing.T) {
+	economy := EconomyConfig{KoopaCoins: Amount(20)}
+	g := *InitializeGame(economyBoard, GameConfig{MaxTurns: 20, Economy: economy})
+	g.Players[0].CurrentSpace = NewChainSpace(0, 3)
+	g.NextEvent.Handle(1, &g) //Pass start, land on blue
+	CoinsIs(33, 0, g, "Koopa", t)
+}
+
+func TestEconomyBoo(t *testing.T) {
+	economy := EconomyConfig{BooStarPrice: Amount(30), BooMaxCoins: Amount(5)}
+	g := *InitializeGame(MinigameBoard, GameConfig{
+		MaxTurns: 20,
+		Economy:  economy,
+	})
+	g.Players[0].Coins = 30
+	g.Players[1].Stars = 1
+	g.NextEvent = EventDiceBlock{0}
+	g.NextEvent.Handle(BooEventBlock, &g)
+	g.NextEvent.Handle(BooStealAction{0, 1, true}, &g)
+	StarsIs(1, 0, g, "Boo star", t)
+	CoinsIs(0, 0, g, "Boo star", t)
+
+	g.NextEvent = EventDiceBlock{1}
+	g.NextEvent.Handle(BooEventBlock, &g)
+	g.NextEvent.Handle(BooStealAction{1, 2, false}, &g)
+	EventIs(BooCoinsEvent{
+		PayRangeEvent{Range{1, 5}, 2},
+		1,
+		0,
+	}, g.NextEvent, "Boo coins", t)
+}
+
+func TestEconomyMinigames(t *testing.T) {
+	economy := EconomyConfig{MinigameCoins: Amount(20)}
+	g := *InitializeGame(MinigameBoard, GameConfig{
+		MaxTurns: 20,
+		Economy:  economy,
+	})
+	g.NextEvent = MinigameFFAReward{}
+	g.NextEvent.Handle(0, &g)
+	CoinsIs(30, 0, g, "FFA", t)
+	g.NextEvent = Minigame2V2Reward{[2]int{0, 1}, [2]int{2, 3}}
+	g.NextEvent.Handle(Minigame2V2BlueWin, &g)
+	CoinsIs(50, 0, g, "2V2", t)
+	CoinsIs(0, 2, g, "2V2", t)
+	g.NextEvent = MinigamePipeMaze{1}
+	g.NextEvent.Handle(3, &g)
+	CoinsIs(20, 3, g, "Pipe Maze", t)
+}
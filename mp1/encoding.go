@@ -0,0 +1,352 @@
+package mp1
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+)
+
+//SaveVersion is the version of the format written by SaveGame. LoadGame
+//refuses to read saves written with a different version. Version 2 added
+//the game's Phase, which can't be told from version 1 saves, version 3
+//the minigame being played and version 4 stopped reading economy amounts
+//of 0 as the default amount.
+const SaveVersion = 4
+
+var boardRegistry = map[string]Board{}
+var typeRegistry = map[string]reflect.Type{}
+var typeNames = map[reflect.Type]string{}
+
+//RegisterBoard registers a Board under name so games played on it can be
+//saved and loaded. Registered boards are matched by their Chains, so a
+//Board must be registered with the same Chains it is played with.
+func RegisterBoard(name string, b Board) {
+	if _, ok := boardRegistry[name]; ok {
+		panic("mp1: board " + name + " registered twice")
+	}
+	boardRegistry[name] = b
+}
+
+//LookupBoard returns the Board registered under name.
+func LookupBoard(name string) (Board, bool) {
+	b, ok := boardRegistry[name]
+	return b, ok
+}
+
+//BoardName returns the name Board b was registered under.
+func BoardName(b Board) (string, bool) {
+	for name, rb := range boardRegistry {
+		if rb.Chains == b.Chains {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+//BoardNames returns the sorted names of all registered boards.
+func BoardNames() []string {
+	names := make([]string, 0, len(boardRegistry))
+	for name := range boardRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//RegisterEvent registers the concrete type of e under name so events of
+//that type can be saved and loaded.
+func RegisterEvent(name string, e Event) {
+	registerType(name, e)
+}
+
+//RegisterResponse registers the concrete type of r under name so
+//responses of that type can be saved and loaded.
+func RegisterResponse(name string, r Response) {
+	registerType(name, r)
+}
+
+//RegisteredName returns the name the concrete type of v was registered
+//under.
+func RegisteredName(v interface{}) (string, bool) {
+	name, ok := typeNames[reflect.TypeOf(v)]
+	return name, ok
+}
+
+func registerType(name string, v interface{}) {
+	t := reflect.TypeOf(v)
+	if rt, ok := typeRegistry[name]; ok {
+		if rt != t {
+			panic("mp1: type name " + name + " registered twice")
+		}
+		return
+	}
+	if _, ok := typeNames[t]; ok {
+		panic("mp1: type " + t.String() + " registered twice")
+	}
+	typeRegistry[name] = t
+	typeNames[t] = name
+}
+
+//typedValue is the encoded form of an Event or Response.
+type typedValue struct {
+	Type  string
+	Value json.RawMessage
+}
+
+func marshalTyped(v interface{}) (*typedValue, error) {
+	if v == nil {
+		return nil, nil
+	}
+	name, ok := RegisteredName(v)
+	if !ok {
+		return nil, fmt.Errorf("mp1: type %T is not registered", v)
+	}
+	value, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &typedValue{name, value}, nil
+}
+
+func unmarshalTyped(tv *typedValue) (interface{}, error) {
+	if tv == nil {
+		return nil, nil
+	}
+	t, ok := typeRegistry[tv.Type]
+	if !ok {
+		return nil, fmt.Errorf("mp1: unknown type %q", tv.Type)
+	}
+	v := reflect.New(t)
+	if err := json.Unmarshal(tv.Value, v.Interface()); err != nil {
+		return nil, err
+	}
+	return v.Elem().Interface(), nil
+}
+
+func unmarshalEvent(tv *typedValue) (Event, error) {
+	v, err := unmarshalTyped(tv)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	evt, ok := v.(Event)
+	if !ok {
+		return nil, fmt.Errorf("mp1: type %q is not an Event", tv.Type)
+	}
+	return evt, nil
+}
+
+//savedGame is the encoded form of a Game.
+type savedGame struct {
+	Version       int
+	Board         string
+	BoardData     json.RawMessage
+	Config        GameConfig
+	StarSpaces    StarData
+	Players       [4]Player
+	Turn          uint8
+	CurrentPlayer int
+	NextEvent     *typedValue
+	Phase         Phase
+	KoopaPasses   int
+	Minigame      *typedValue
+	MinigameCoins [4]int
+}
+
+//MarshalJSON encodes the full game state, including the board specific
+//data and the pending event. The Board must be registered.
+func (g *Game) MarshalJSON() ([]byte, error) {
+	name, ok := BoardName(g.Board)
+	if !ok {
+		return nil, fmt.Errorf("mp1: board is not registered")
+	}
+	boardData, err := json.Marshal(g.Board.Data)
+	if err != nil {
+		return nil, err
+	}
+	nextEvent, err := marshalTyped(g.NextEvent)
+	if err != nil {
+		return nil, err
+	}
+	minigame, err := marshalTyped(g.Minigame.Minigame)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(savedGame{
+		Version:       SaveVersion,
+		Board:         name,
+		BoardData:     boardData,
+		Config:        g.Config,
+		StarSpaces:    g.StarSpaces,
+		Players:       g.Players,
+		Turn:          g.Turn,
+		CurrentPlayer: g.CurrentPlayer,
+		NextEvent:     nextEvent,
+		Phase:         g.Phase,
+		KoopaPasses:   g.KoopaPasses,
+		Minigame:      minigame,
+		MinigameCoins: g.Minigame.Coins,
+	})
+}
+
+//UnmarshalJSON decodes a game encoded by MarshalJSON.
+func (g *Game) UnmarshalJSON(data []byte) error {
+	var s savedGame
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s.Version != SaveVersion {
+		return fmt.Errorf("mp1: unsupported save version %d", s.Version)
+	}
+	b, ok := LookupBoard(s.Board)
+	if !ok {
+		return fmt.Errorf("mp1: unknown board %q", s.Board)
+	}
+	if b.Data != nil {
+		bd := reflect.New(reflect.TypeOf(b.Data))
+		if err := json.Unmarshal(s.BoardData, bd.Interface()); err != nil {
+			return err
+		}
+		b.Data = bd.Elem().Interface()
+	}
+	nextEvent, err := unmarshalEvent(s.NextEvent)
+	if err != nil {
+		return err
+	}
+	minigame, err := unmarshalTyped(s.Minigame)
+	if err != nil {
+		return err
+	}
+	*g = Game{
+		Board:         b,
+		Config:        s.Config,
+		StarSpaces:    s.StarSpaces,
+		Players:       s.Players,
+		Turn:          s.Turn,
+		CurrentPlayer: s.CurrentPlayer,
+		NextEvent:     nextEvent,
+		Phase:         s.Phase,
+		KoopaPasses:   s.KoopaPasses,
+		Minigame:      MinigameTally{minigame, s.MinigameCoins},
+		listeners:     g.listeners,
+	}
+	return nil
+}
+
+//SaveGame writes g to w as JSON.
+func SaveGame(w io.Writer, g *Game) error {
+	return json.NewEncoder(w).Encode(g)
+}
+
+//LoadGame reads a game written by SaveGame from r.
+func LoadGame(r io.Reader) (*Game, error) {
+	g := &Game{}
+	if err := json.NewDecoder(r).Decode(g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+//MarshalJSON encodes t, including the type of the underlying minigame.
+func (t Throwable1V3Minigame) MarshalJSON() ([]byte, error) {
+	minigame, err := marshalTyped(t.Minigame)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		Player   int
+		Minigame *typedValue
+	}{t.Player, minigame})
+}
+
+//UnmarshalJSON decodes a Throwable1V3Minigame encoded by MarshalJSON.
+func (t *Throwable1V3Minigame) UnmarshalJSON(data []byte) error {
+	var s struct {
+		Player   int
+		Minigame *typedValue
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	minigame, err := unmarshalEvent(s.Minigame)
+	if err != nil {
+		return err
+	}
+	t.Player = s.Player
+	t.Minigame = minigame
+	return nil
+}
+
+func init() {
+	//Events
+	RegisterEvent("BranchEvent", BranchEvent{})
+	RegisterEvent("MushroomEvent", MushroomEvent{})
+	RegisterEvent("BooCoinsEvent", BooCoinsEvent{})
+	RegisterEvent("BooEvent", BooEvent{})
+	RegisterEvent("DeterminePlayerTeamEvent", DeterminePlayerTeamEvent{})
+	RegisterEvent("StarLocationEvent", StarLocationEvent{})
+	RegisterEvent("HiddenBlockEvent", HiddenBlockEvent{})
+	RegisterEvent("NormalDiceBlock", NormalDiceBlock{})
+	RegisterEvent("RedDiceBlock", RedDiceBlock{})
+	RegisterEvent("BlueDiceBlock", BlueDiceBlock{})
+	RegisterEvent("WarpDiceBlock", WarpDiceBlock{})
+	RegisterEvent("EventDiceBlock", EventDiceBlock{})
+	RegisterEvent("PickDiceBlock", PickDiceBlock{})
+	RegisterEvent("ChanceTime", ChanceTime{})
+	RegisterEvent("BowserEvent", BowserEvent{})
+	RegisterEvent("BowserBalloonBurstEvent", BowserBalloonBurstEvent{})
+	RegisterEvent("BowsersFaceLiftEvent", BowsersFaceLiftEvent{})
+	RegisterEvent("BowsersTugoWarEvent", BowsersTugoWarEvent{})
+	RegisterEvent("BowsersBashnCash", BowsersBashnCash{})
+	RegisterEvent("BowsersChanceTimeEvent", BowsersChanceTimeEvent{})
+	RegisterEvent("MinigameFFAReward", MinigameFFAReward{})
+	RegisterEvent("DrawableFFAReward", DrawableFFAReward{})
+	RegisterEvent("CoinMinigameFFAReward", CoinMinigameFFAReward{})
+	RegisterEvent("MinigameFFAMultiWinReward", MinigameFFAMultiWinReward{})
+	RegisterEvent("MinigameFFA1Loser", MinigameFFA1Loser{})
+	RegisterEvent("MinigameFFACoop", MinigameFFACoop{})
+	RegisterEvent("MinigameGrabBag", MinigameGrabBag{})
+	RegisterEvent("MinigameFFASelector", MinigameFFASelector{})
+	RegisterEvent("Minigame2V2Reward", Minigame2V2Reward{})
+	RegisterEvent("CoinMinigame2V2Reward", CoinMinigame2V2Reward{})
+	RegisterEvent("Minigame2V2Selector", Minigame2V2Selector{})
+	RegisterEvent("Minigame1V3Reward", Minigame1V3Reward{})
+	RegisterEvent("Throwable1V3Minigame", Throwable1V3Minigame{})
+	RegisterEvent("MinigamePipeMaze", MinigamePipeMaze{})
+	RegisterEvent("MinigameBashnCash", MinigameBashnCash{})
+	RegisterEvent("MinigameBashnCashCoinAwards", MinigameBashnCashCoinAwards{})
+	RegisterEvent("MinigameBowlOver", MinigameBowlOver{})
+	RegisterEvent("MinigameCraneGameCoins", MinigameCraneGameCoins{})
+	RegisterEvent("MinigameCraneGamePlayers", MinigameCraneGamePlayers{})
+	RegisterEvent("MinigamePaddleBattle", MinigamePaddleBattle{})
+	RegisterEvent("Minigame1V3Selector", Minigame1V3Selector{})
+	RegisterEvent("Minigame1PRewards", Minigame1PRewards{})
+	RegisterEvent("MinigameMemoryMatch", MinigameMemoryMatch{})
+	RegisterEvent("MinigameSlotMachine", MinigameSlotMachine{})
+	RegisterEvent("MinigameWhackaPlant", MinigameWhackaPlant{})
+	RegisterEvent("MinigameTeeteringTowers", MinigameTeeteringTowers{})
+	RegisterEvent("Minigame1PSelector", Minigame1PSelector{})
+
+	//Responses
+	RegisterResponse("int", 0)
+	RegisterResponse("ChainSpace", ChainSpace{})
+	RegisterResponse("MushroomEventResponse", RedMushroom)
+	RegisterResponse("BooStealAction", BooStealAction{})
+	RegisterResponse("MinigameTeam", BlueTeam)
+	RegisterResponse("HiddenBlockResponse", HiddenBlockAppears)
+	RegisterResponse("EventBlockEvent", BooEventBlock)
+	RegisterResponse("ChanceTimeResponse", ChanceTimeResponse{})
+	RegisterResponse("BowserResponse", CoinsForBowser)
+	RegisterResponse("BowsersTugoWarResult", BTWDraw)
+	RegisterResponse("BCTResponse", BCTResponse{})
+	RegisterResponse("MinigameFFACoopResponse", MinigameFFACoopWin)
+	RegisterResponse("MinigameFFAGame", MinigameFFABurriedTreasure)
+	RegisterResponse("Minigame2V2Result", Minigame2V2BlueWin)
+	RegisterResponse("Minigame2V2Game", Minigame2V2BobsledRun)
+	RegisterResponse("Minigame1V3Result", Minigame1V3SingleWin)
+	RegisterResponse("Throwable1V3MinigameResponse", Throwable1V3MinigameThrow)
+	RegisterResponse("MinigameBowlOverResponse", MinigameBowlOverResponse{})
+	RegisterResponse("Minigame1V3Game", Minigame1V3PipeMaze)
+	RegisterResponse("Minigame1PGame", Minigame1PMemoryMatch)
+}
@@ -0,0 +1,144 @@
+package mp1
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func init() {
+	RegisterBoard("test-chance", ChanceBoard)
+	RegisterBoard("test-minigame", MinigameBoard)
+}
+
+func SaveAndLoad(g *Game, t *testing.T) *Game {
+	var buf bytes.Buffer
+	if err := SaveGame(&buf, g); err != nil {
+		t.Fatalf("Expected no save error, got: %v", err)
+	}
+	loaded, err := LoadGame(&buf)
+	if err != nil {
+		t.Fatalf("Expected no load error, got: %v", err)
+	}
+	return loaded
+}
+
+func TestSaveLoadMidChanceTime(t *testing.T) {
+	g := InitializeGame(ChanceBoard, GameConfig{MaxTurns: 20, RedDice: true})
+	g.Players[0].Char = "Mario"
+	g.Players[1].Coins = 25
+	g.Players[1].Stars = 2
+	g.MovePlayer(0, 1)
+	g.NextEvent.Handle(ChanceTimeResponse{CTBLeft, 1}, g)
+
+	loaded := SaveAndLoad(g, t)
+	if !reflect.DeepEqual(g, loaded) {
+		t.Fatalf("Expected game:\n%#v\ngot:\n%#v", g, loaded)
+	}
+
+	g.NextEvent.Handle(ChanceTimeResponse{CTBRight, 0}, g)
+	g.NextEvent.Handle(ChanceTimeResponse{CTBMiddle, int(LTRStar)}, g)
+	loaded.NextEvent.Handle(ChanceTimeResponse{CTBRight, 0}, loaded)
+	loaded.NextEvent.Handle(ChanceTimeResponse{CTBMiddle, int(LTRStar)}, loaded)
+	StarsIs(1, 0, *loaded, "", t)
+	if !reflect.DeepEqual(g, loaded) {
+		t.Errorf("Expected game:\n%#v\ngot:\n%#v", g, loaded)
+	}
+}
+
+func TestSaveLoadNestedEvent(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	g.NextEvent = Throwable1V3Minigame{
+		2,
+		CoinMinigameFFAReward{Range{0, 30}, 0},
+	}
+
+	loaded := SaveAndLoad(g, t)
+	EventIs(g.NextEvent, loaded.NextEvent, "", t)
+}
+
+func TestSaveLoadMidMinigame(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	g.NextEvent = MinigameFFASelector{}
+	g.HandleEvent(MinigameFFATreasureDivers)
+	g.HandleEvent(5)
+
+	loaded := SaveAndLoad(g, t)
+	if !reflect.DeepEqual(g, loaded) {
+		t.Fatalf("Expected game:\n%#v\ngot:\n%#v", g, loaded)
+	}
+	events := RecordEvents(loaded)
+	for loaded.Minigame.Minigame != nil {
+		loaded.HandleEvent(1)
+	}
+	expected := MinigamePlayed{MinigameFFATreasureDivers, [4]int{5, 1, 1, 1}}
+	if len(*events) < 4 || (*events)[3] != expected {
+		t.Errorf("Expected %#v after the coins, got: %#v", expected, *events)
+	}
+}
+
+func TestSaveUnregisteredBoard(t *testing.T) {
+	g := InitializeGame(BowserBoard, GameConfig{MaxTurns: 20})
+	var buf bytes.Buffer
+	if err := SaveGame(&buf, g); err == nil {
+		t.Errorf("Expected error saving unregistered board")
+	}
+}
+
+func TestLoadUnsupportedVersion(t *testing.T) {
+	g := InitializeGame(ChanceBoard, GameConfig{MaxTurns: 20})
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = bytes.Replace(data, []byte(`"Version":4`), []byte(`"Version":99`), 1)
+	if _, err := LoadGame(bytes.NewReader(data)); err == nil ||
+		!strings.Contains(err.Error(), "version") {
+		t.Errorf("Expected version error, got: %v", err)
+	}
+}
+
+func TestLoadPrePhaseSave(t *testing.T) {
+	g := InitializeGame(ChanceBoard, GameConfig{MaxTurns: 20})
+	g.MovePlayer(0, 1)
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var save map[string]json.RawMessage
+	if err := json.Unmarshal(data, &save); err != nil {
+		t.Fatal(err)
+	}
+	delete(save, "Phase")
+	save["Version"] = json.RawMessage("1")
+	data, err = json.Marshal(save)
+	if err != nil {
+		t.Fatal(err)
+	}
+	//A version 1 save mid Chance Time would otherwise load in RollPhase
+	if _, err := LoadGame(bytes.NewReader(data)); err == nil ||
+		!strings.Contains(err.Error(), "version 1") {
+		t.Errorf("Expected version error, got: %v", err)
+	}
+}
+
+func TestRegisteredTypesRoundTrip(t *testing.T) {
+	for name, typ := range typeRegistry {
+		v := reflect.New(typ).Elem().Interface()
+		tv, err := marshalTyped(v)
+		if err != nil {
+			t.Errorf("Expected no marshal error for %s, got: %v", name, err)
+			continue
+		}
+		got, err := unmarshalTyped(tv)
+		if err != nil {
+			t.Errorf("Expected no unmarshal error for %s, got: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(v, got) {
+			t.Errorf("Expected %s: %#v, got: %#v", name, v, got)
+		}
+	}
+}
@@ -49,10 +49,30 @@ const (
 	CHAINSPACE_EVT_TYPE
 )
 
+//String returns the name of t used by EventDescriptor: enum, range, coin,
+//player, multiwin_player or chainspace.
+func (t EventType) String() string {
+	switch t {
+	case ENUM_EVT_TYPE:
+		return "enum"
+	case RANGE_EVT_TYPE:
+		return "range"
+	case COIN_EVT_TYPE:
+		return "coin"
+	case PLAYER_EVT_TYPE:
+		return "player"
+	case MULTIWIN_PLAYER_EVT_TYPE:
+		return "multiwin_player"
+	case CHAINSPACE_EVT_TYPE:
+		return "chainspace"
+	}
+	return ""
+}
+
 //Event is an action that can be responded to via a Response.
 type Event interface {
 	//Responses returns a list of all responses that this event can
-	//handle.
+	//handle. The slice is new on every call, so callers may modify it.
 	Responses() []Response
 
 	//ControllingPlayer returns the player that is responding to the
@@ -100,6 +120,14 @@ func (b BranchEvent) Responses() []Response {
 	return ret
 }
 
+func (b BranchEvent) ResponseCount() int {
+	return len(*b.Links)
+}
+
+func (b BranchEvent) ResponseAt(i int) Response {
+	return (*b.Links)[i]
+}
+
 //Handle moves the player to the selected ChainSpace. The player then
 //moves the remaining spaces - 1.
 func (b BranchEvent) Handle(r Response, g *Game) {
@@ -147,6 +175,16 @@ func (m MushroomEventResponse) String() string {
 	return ""
 }
 
+func (m MushroomEventResponse) Key() string {
+	switch m {
+	case RedMushroom:
+		return "RedMushroom"
+	case PoisonMushroom:
+		return "PoisonMushroom"
+	}
+	return ""
+}
+
 //MushroomEvent occurs when a player lands on a Mushroom Space.
 type MushroomEvent struct {
 	Player int
@@ -161,8 +199,18 @@ func (m MushroomEvent) Type() EventType {
 	return ENUM_EVT_TYPE
 }
 
+var mushroomResponses = []Response{RedMushroom, PoisonMushroom}
+
 func (m MushroomEvent) Responses() []Response {
-	return []Response{RedMushroom, PoisonMushroom}
+	return responses(m)
+}
+
+func (m MushroomEvent) ResponseCount() int {
+	return len(mushroomResponses)
+}
+
+func (m MushroomEvent) ResponseAt(i int) Response {
+	return mushroomResponses[i]
 }
 
 //Handle sets next players turn. If r == true, then player m.Player goes
@@ -221,6 +269,8 @@ type BooEvent struct {
 	Players [4]Player
 	Moves   int //No call to MovePlayer on 0
 	Coins   int
+	//StarPrice is the cost of stealing a star, from the game's Economy.
+	StarPrice int
 }
 
 //BooStealAction describes an action a player passing Boo may take.
@@ -233,7 +283,7 @@ type BooStealAction struct {
 func (b BooStealAction) String() string {
 	givingPlayer := strconv.Itoa(b.GivingPlayer + 1)
 	if b.Star {
-		return "Steal 1 star from player " + givingPlayer + " for 50 coins"
+		return "Steal 1 star from player " + givingPlayer
 	} else {
 		return "Steal coins from player " + givingPlayer
 	}
@@ -250,26 +300,60 @@ func (b BooEvent) Type() EventType {
 
 //Responses returns a slice of BooStealActions that b.Player can take.
 func (b BooEvent) Responses() []Response {
-	res := make([]Response, 0)
-	if b.Coins >= 50 {
-		for i := 0; i < 4; i++ {
-			if i == b.Player {
-				continue
-			}
-			if b.Players[i].Stars > 0 {
-				res = append(res, BooStealAction{b.Player, i, true})
-			}
+	return responses(b)
+}
+
+func (b BooEvent) ResponseCount() int {
+	_, count := b.action(-1)
+	return count
+}
+
+func (b BooEvent) ResponseAt(i int) Response {
+	action, count := b.action(i)
+	if i < 0 || i >= count {
+		panic("mp1: BooEvent response index out of range")
+	}
+	star := 0
+	if action.Star {
+		star = 1
+	}
+	return booStealActions[action.RecvPlayer][action.GivingPlayer][star]
+}
+
+//booStealActions holds every BooStealAction by receiving player, giving
+//player and star steal, so ResponseAt does not allocate.
+var booStealActions = func() (ret [4][4][2]Response) {
+	for recv := range ret {
+		for giving := range ret[recv] {
+			ret[recv][giving][0] = BooStealAction{recv, giving, false}
+			ret[recv][giving][1] = BooStealAction{recv, giving, true}
 		}
 	}
-	for i := 0; i < 4; i++ {
-		if i == b.Player {
+	return ret
+}()
+
+//action returns the ith BooStealAction that b.Player can take, star steals
+//first, and the number of actions they can take.
+func (b BooEvent) action(i int) (action BooStealAction, count int) {
+	for _, star := range [2]bool{true, false} {
+		if star && b.Coins < b.StarPrice {
 			continue
 		}
-		if b.Players[i].Coins > 0 {
-			res = append(res, BooStealAction{b.Player, i, false})
+		for p := 0; p < 4; p++ {
+			if p == b.Player {
+				continue
+			}
+			if star && b.Players[p].Stars <= 0 ||
+				!star && b.Players[p].Coins <= 0 {
+				continue
+			}
+			if count == i {
+				action = BooStealAction{b.Player, p, star}
+			}
+			count++
 		}
 	}
-	return res
+	return action, count
 }
 
 //Handle applies the BooStealAction r for b.Player. After applying r, the
@@ -277,10 +361,11 @@ func (b BooEvent) Responses() []Response {
 func (b BooEvent) Handle(r Response, g *Game) {
 	steal := r.(BooStealAction)
 	if steal.Star {
-		g.AwardCoins(steal.RecvPlayer, -50, false)
-		g.Players[steal.GivingPlayer].Stars--
+		g.AwardCoins(steal.RecvPlayer, -b.StarPrice, false)
+		g.AwardStars(steal.GivingPlayer, -1)
+		g.AwardStars(steal.RecvPlayer, 1)
 	} else {
-		maxCoins := 15
+		maxCoins := g.Economy().BooMaxCoins
 		if b.Players[steal.GivingPlayer].Coins <= maxCoins {
 			maxCoins = b.Players[steal.GivingPlayer].Coins
 		}
@@ -317,10 +402,20 @@ func (d DeterminePlayerTeamEvent) Type() EventType {
 	return ENUM_EVT_TYPE
 }
 
+var teamResponses = []Response{BlueTeam, RedTeam}
+
 //Responses returns BlueTeam and RedTeam, the two available teams a player
 //can be on.
 func (d DeterminePlayerTeamEvent) Responses() []Response {
-	return []Response{BlueTeam, RedTeam}
+	return responses(d)
+}
+
+func (d DeterminePlayerTeamEvent) ResponseCount() int {
+	return len(teamResponses)
+}
+
+func (d DeterminePlayerTeamEvent) ResponseAt(i int) Response {
+	return teamResponses[i]
 }
 
 //Handle sets d.Player's team. If r is true, d.Player's team is blue. If r
@@ -364,3 +459,16 @@ func (r Range) Responses() []Response {
 	}
 	return ret
 }
+
+//ResponseCount returns the number of ints in [r.Min,r.Max].
+func (r Range) ResponseCount() int {
+	return max(r.Max-r.Min+1, 0)
+}
+
+//ResponseAt returns r.Min+i.
+func (r Range) ResponseAt(i int) Response {
+	if i < 0 || r.Min+i > r.Max {
+		panic("mp1: Range response index out of range")
+	}
+	return r.Min + i
+}
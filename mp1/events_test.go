@@ -0,0 +1,18 @@
+package mp1
+
+import "testing"
+
+func TestBooStealStar(t *testing.T) {
+	g := *InitializeGame(partyBoard, GameConfig{MaxTurns: 20})
+	g.HandleEvent(NewChainSpace(0, 7))
+	g.Players[0].CurrentSpace = NewChainSpace(0, 4)
+	g.Players[0].Coins = 60
+	g.Players[1].Stars = 1
+
+	g.HandleEvent(1) //Pass Boo, land on the mushroom space
+	g.HandleEvent(BooStealAction{0, 1, true})
+	StarsIs(1, 0, g, "Thief", t)
+	StarsIs(0, 1, g, "Victim", t)
+	CoinsIs(10, 0, g, "Thief", t)
+	EventIs(MushroomEvent{0}, g.NextEvent, "Moves", t)
+}
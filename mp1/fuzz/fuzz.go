@@ -0,0 +1,234 @@
+//Package fuzz plays random games on any board and checks the engine's
+//invariants after every event. Failing games are minimized into
+//mp1.ReplayLogs that reproduce them.
+package fuzz
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//DefaultMaxSteps is the number of events a game may take before it is
+//considered not to terminate. Games of 50 turns take around 2000.
+const DefaultMaxSteps = 100000
+
+//Case is a game to play: the name of its board, its config and the seed
+//of the random responses. Logs of the game use Board as their board
+//name.
+type Case struct {
+	Board  string
+	Config mp1.GameConfig
+	Seed   int64
+}
+
+//Failure is a game that broke an invariant.
+type Failure struct {
+	//Log holds the responses that lead to the failure, the last response
+	//is the one that caused it. Log.Checksum is empty.
+	Log mp1.ReplayLog
+	//Event is the event the last response was given to.
+	Event mp1.Event
+	Err   error
+
+	kind  string
+	board mp1.Board
+	//choices holds the index of every response in Log.
+	choices []int
+}
+
+func (f *Failure) Error() string {
+	return fmt.Sprintf("fuzz: %s after %d responses on %s (%T): %v",
+		f.kind, len(f.Log.Responses), f.Log.Board, f.Event, f.Err)
+}
+
+//Kind returns what failed: "panic", "invariant", "stalled" if an event
+//was still pending after its response, "no responses" or "unterminated".
+func (f *Failure) Kind() string {
+	return f.kind
+}
+
+//Unwrap returns the invariant or panic that failed.
+func (f *Failure) Unwrap() error {
+	return f.Err
+}
+
+//Failure kinds.
+const (
+	panicked     = "panic"
+	invariant    = "invariant"
+	stalled      = "stalled"
+	noResponses  = "no responses"
+	unterminated = "unterminated"
+)
+
+//Configs returns every combination of the boolean GameConfig options, with
+//maxTurns turns.
+func Configs(maxTurns uint8) []mp1.GameConfig {
+	configs := make([]mp1.GameConfig, 0, 1<<7)
+	for bits := 0; bits < 1<<7; bits++ {
+		configs = append(configs, config(maxTurns, bits))
+	}
+	return configs
+}
+
+//config returns the config with the options set by the bits of bits.
+func config(maxTurns uint8, bits int) mp1.GameConfig {
+	return mp1.GameConfig{
+		MaxTurns:     maxTurns,
+		NoBonusStars: bits&1 != 0,
+		NoKoopa:      bits&2 != 0,
+		NoBoo:        bits&4 != 0,
+		RedDice:      bits&8 != 0,
+		BlueDice:     bits&16 != 0,
+		WarpDice:     bits&32 != 0,
+		EventsDice:   bits&64 != 0,
+	}
+}
+
+//Play plays c on b to the end with uniformly random responses, checking
+//the invariants after every event. It returns the game's log, or the
+//Failure of the first broken invariant. A game that takes more than
+//maxSteps events fails.
+func Play(b mp1.Board, c Case, maxSteps int) (*mp1.ReplayLog, error) {
+	r := rand.New(rand.NewSource(c.Seed))
+	p := newPlayer(c.Board, b, c.Config)
+	for i := 0; p.g.NextEvent != nil; i++ {
+		if i == maxSteps {
+			return nil, p.fail(unterminated,
+				fmt.Errorf("game did not end after %d events", maxSteps))
+		}
+		if f := p.step(r.Intn); f != nil {
+			return nil, f
+		}
+	}
+	return p.log, nil
+}
+
+//PlayChoices plays a game on b, logged as name, with config, responding
+//to each event with the response at the next index of choices, modulo
+//the number of responses. It returns the Failure of the first broken
+//invariant, or nil if the game ends or choices run out first.
+func PlayChoices(b mp1.Board, name string, config mp1.GameConfig, choices []int) *Failure {
+	p := newPlayer(name, b, config)
+	for _, c := range choices {
+		if p.g.NextEvent == nil {
+			return nil
+		}
+		f := p.step(func(n int) int { return c % n })
+		if f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+//Check replays l on its registered board, checking the invariants after
+//every event. It returns the Failure of the first broken invariant, or
+//nil if there is none. Responses that are not valid for their event are
+//an error too.
+func Check(l mp1.ReplayLog) error {
+	b, ok := mp1.LookupBoard(l.Board)
+	if !ok {
+		return fmt.Errorf("fuzz: unknown board %q", l.Board)
+	}
+	return CheckBoard(b, l)
+}
+
+//CheckBoard replays l on b, as Check does, whether or not b is
+//registered.
+func CheckBoard(b mp1.Board, l mp1.ReplayLog) error {
+	p := newPlayer(l.Board, b, l.Config)
+	p.log.Chars = l.Chars
+	for i, c := range l.Chars {
+		p.g.Players[i].Char = c
+	}
+	for i, res := range l.Responses {
+		if p.g.NextEvent == nil {
+			return fmt.Errorf("fuzz: game ended before response %d", i)
+		}
+		c := index(p.g.NextEvent, res)
+		if c < 0 {
+			return fmt.Errorf("fuzz: response %d (%#v) is not valid for %T",
+				i, res, p.g.NextEvent)
+		}
+		if f := p.step(func(int) int { return c }); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+//index returns the index of res in e's responses, or -1 if it is not one
+//of them.
+func index(e mp1.Event, res mp1.Response) int {
+	for i, n := 0, mp1.ResponseCount(e); i < n; i++ {
+		if mp1.ResponseAt(e, i) == res {
+			return i
+		}
+	}
+	return -1
+}
+
+//player plays a game, recording and checking every response.
+type player struct {
+	//board is the board the game started on.
+	board   mp1.Board
+	g       *mp1.Game
+	log     *mp1.ReplayLog
+	choices []int
+	//event is the last event a response was given to.
+	event mp1.Event
+}
+
+//newPlayer starts a game on b with config, logged as name.
+func newPlayer(name string, b mp1.Board, config mp1.GameConfig) *player {
+	g := mp1.InitializeGame(b, config)
+	log := &mp1.ReplayLog{Board: name, Config: config}
+	for i, p := range g.Players {
+		log.Chars[i] = p.Char
+	}
+	return &player{board: b, g: g, log: log}
+}
+
+//step handles the next event with the response at the index returned by
+//choose, which is given the number of responses, and checks the
+//invariants.
+func (p *player) step(choose func(n int) int) (f *Failure) {
+	defer func() {
+		if v := recover(); v != nil {
+			f = p.fail(panicked, fmt.Errorf("%v", v))
+		}
+	}()
+	prev := p.g.NextEvent
+	p.event = prev
+	n := mp1.ResponseCount(prev)
+	if n == 0 {
+		return p.fail(noResponses, fmt.Errorf("%#v has no responses", prev))
+	}
+	c := choose(n)
+	res := mp1.ResponseAt(prev, c)
+	p.choices = append(p.choices, c)
+	p.log.HandleEvent(p.g, res)
+	if err := p.g.CheckInvariants(); err != nil {
+		return p.fail(invariant, err)
+	}
+	if p.g.NextEvent != nil && reflect.DeepEqual(p.g.NextEvent, prev) {
+		return p.fail(stalled, fmt.Errorf("%#v is still pending after %#v",
+			prev, res))
+	}
+	return nil
+}
+
+func (p *player) fail(kind string, err error) *Failure {
+	return &Failure{
+		Log:     *p.log,
+		Event:   p.event,
+		Err:     err,
+		kind:    kind,
+		board:   p.board,
+		choices: p.choices,
+	}
+}
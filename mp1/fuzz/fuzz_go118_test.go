@@ -0,0 +1,44 @@
+//go:build go1.18
+// +build go1.18
+
+package fuzz
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//FuzzPlay plays a random game for every board, config, turn count and
+//seed the fuzzer generates. A failing game is minimized and its log
+//printed, ready to be saved in testdata.
+//
+//	go test -fuzz FuzzPlay ./mp1/fuzz
+func FuzzPlay(f *testing.F) {
+	boards := mp1.BoardNames()
+	for i := range boards {
+		f.Add(uint8(i), uint8(0), uint8(20), int64(0))
+		f.Add(uint8(i), uint8(127), uint8(50), int64(1))
+	}
+	f.Fuzz(func(t *testing.T, board, bits, turns uint8, seed int64) {
+		c := Case{
+			Board:  boards[int(board)%len(boards)],
+			Config: config(1+turns%50, int(bits)),
+			Seed:   seed,
+		}
+		b, _ := mp1.LookupBoard(c.Board)
+		_, err := Play(b, c, DefaultMaxSteps)
+		var failure *Failure
+		if errors.As(err, &failure) {
+			m := Minimize(failure)
+			var buf bytes.Buffer
+			mp1.WriteReplay(&buf, m.Log)
+			t.Fatalf("%v\n%s", m, buf.String())
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
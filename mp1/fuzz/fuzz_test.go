@@ -0,0 +1,174 @@
+package fuzz
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	_ "github.com/0xhexnumbers/partysim/mp1/board"
+)
+
+//brokenBoard takes the landing player's stars below 0 on its happening
+//space from turn 5 onwards.
+var brokenBoard = mp1.Board{
+	Chains: &[]mp1.Chain{
+		{
+			{Type: mp1.Start},
+			{Type: mp1.Blue},
+			{Type: mp1.Red},
+			{Type: mp1.Blue},
+			{Type: mp1.Blue},
+			{Type: mp1.Red},
+			{Type: mp1.Happening, StoppingEvent: func(g *mp1.Game, player int) {
+				if g.Turn >= 5 {
+					g.AwardStars(player, -1)
+				}
+			}},
+			{Type: mp1.Blue},
+		},
+	},
+	Links: &map[int]*[]mp1.ChainSpace{
+		0: {mp1.NewChainSpace(0, 0)},
+	},
+}
+
+func TestConfigs(t *testing.T) {
+	configs := Configs(35)
+	seen := map[mp1.GameConfig]bool{}
+	for _, c := range configs {
+		if c.MaxTurns != 35 {
+			t.Errorf("Expected MaxTurns: 35, got: %d", c.MaxTurns)
+		}
+		seen[c] = true
+	}
+	if len(configs) != 128 || len(seen) != 128 {
+		t.Errorf("Expected 128 configs, got: %d, %d distinct",
+			len(configs), len(seen))
+	}
+}
+
+func TestPlay(t *testing.T) {
+	seeds := int64(2)
+	if testing.Short() {
+		seeds = 1
+	}
+	for _, name := range mp1.BoardNames() {
+		b, _ := mp1.LookupBoard(name)
+		for _, c := range Configs(20) {
+			for seed := int64(0); seed < seeds; seed++ {
+				l, err := Play(b, Case{name, c, seed}, DefaultMaxSteps)
+				if err != nil {
+					t.Fatalf("%s %+v seed %d: %v", name, c, seed, err)
+				}
+				if err := Check(*l); err != nil {
+					t.Fatalf("%s %+v seed %d: Check: %v", name, c, seed, err)
+				}
+			}
+		}
+	}
+}
+
+//brokenFailure returns the first failing game on brokenBoard.
+func brokenFailure(t *testing.T) *Failure {
+	t.Helper()
+	for seed := int64(0); seed < 100; seed++ {
+		c := Case{"broken", mp1.GameConfig{MaxTurns: 20}, seed}
+		_, err := Play(brokenBoard, c, DefaultMaxSteps)
+		var f *Failure
+		if errors.As(err, &f) {
+			return f
+		}
+	}
+	t.Fatal("Expected a game on the broken board to fail")
+	return nil
+}
+
+func TestPlayFailure(t *testing.T) {
+	f := brokenFailure(t)
+	if f.kind != invariant {
+		t.Errorf("Expected %s failure, got: %v", invariant, f)
+	}
+	if f.Log.Board != "broken" {
+		t.Errorf("Expected failure on broken, got: %s", f.Log.Board)
+	}
+	if err := CheckBoard(brokenBoard, f.Log); err == nil {
+		t.Error("Expected CheckBoard of the failure's log to fail")
+	}
+	if err := Check(f.Log); err == nil || errors.As(err, new(*Failure)) {
+		t.Errorf("Expected Check of an unregistered board to fail, "+
+			"got: %v", err)
+	}
+}
+
+func TestPlayUnterminated(t *testing.T) {
+	b, _ := mp1.LookupBoard("MRC")
+	_, err := Play(b, Case{"MRC", mp1.GameConfig{MaxTurns: 20}, 0}, 10)
+	var f *Failure
+	if !errors.As(err, &f) || f.kind != unterminated {
+		t.Errorf("Expected %s failure, got: %v", unterminated, err)
+	}
+	if Minimize(f) != f {
+		t.Error("Expected unterminated game not to be minimized")
+	}
+}
+
+func TestMinimize(t *testing.T) {
+	f := brokenFailure(t)
+	m := Minimize(f)
+	if m.kind != f.kind {
+		t.Errorf("Expected %s failure, got: %v", f.kind, m)
+	}
+	if len(m.Log.Responses) >= len(f.Log.Responses) {
+		t.Errorf("Expected fewer than %d responses, got: %d",
+			len(f.Log.Responses), len(m.Log.Responses))
+	}
+	err := CheckBoard(brokenBoard, m.Log)
+	var c *Failure
+	if !errors.As(err, &c) || c.kind != f.kind ||
+		len(c.Log.Responses) != len(m.Log.Responses) {
+		t.Errorf("Expected minimized log to replay %v, got: %v", m, err)
+	}
+}
+
+func TestCheckInvalid(t *testing.T) {
+	b, _ := mp1.LookupBoard("DKJA")
+	c := Case{"DKJA", mp1.GameConfig{MaxTurns: 20}, 1}
+	l, err := Play(b, c, DefaultMaxSteps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := len(l.Responses)
+	ended := *l
+	ended.Responses = append(l.Responses[:n:n], 1)
+	if err := Check(ended); err == nil {
+		t.Error("Expected response after the game ended to fail")
+	}
+	l.Responses[0] = "Not a response"
+	if err := Check(*l); err == nil {
+		t.Error("Expected invalid response to fail")
+	}
+}
+
+//TestRegressions checks the minimized logs of fixed failures in testdata.
+func TestRegressions(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		r, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		l, err := mp1.ReadReplay(r)
+		r.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		if err := Check(l); err != nil {
+			t.Errorf("%s: %v", file, err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package fuzz
+
+import "reflect"
+
+//Minimize returns a shorter game that fails in the same way as f: it
+//breaks the same kind of invariant or panics, after a response to the
+//same type of event. Chunks of responses are removed while the game still
+//fails, then the remaining responses are replaced by the first response
+//of their event, until neither shortens the game. The minimized Failure's
+//Log replays the failure with CheckBoard. Games that do not terminate are
+//returned as they are.
+func Minimize(f *Failure) *Failure {
+	if f.kind == unterminated {
+		return f
+	}
+	best := f
+	//try plays choices and keeps the result if it still fails the same
+	//way.
+	try := func(choices []int) {
+		g := PlayChoices(f.board, f.Log.Board, f.Log.Config, choices)
+		if g != nil && g.kind == f.kind &&
+			reflect.TypeOf(g.Event) == reflect.TypeOf(f.Event) {
+			best = g
+		}
+	}
+	for {
+		prev := best
+		//The last choice causes the failure, so only the ones before it
+		//are removed.
+		for chunk := len(best.choices) / 2; chunk > 0; chunk /= 2 {
+			for i := len(best.choices) - 1 - chunk; i >= 0; i -= chunk {
+				if i+chunk > len(best.choices)-1 {
+					continue
+				}
+				choices := append(append([]int{}, best.choices[:i]...),
+					best.choices[i+chunk:]...)
+				try(choices)
+			}
+		}
+		for i := 0; i < len(best.choices)-1; i++ {
+			if best.choices[i] == 0 {
+				continue
+			}
+			choices := append([]int{}, best.choices...)
+			choices[i] = 0
+			try(choices)
+		}
+		if best == prev {
+			return best
+		}
+	}
+}
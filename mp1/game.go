@@ -1,6 +1,7 @@
 package mp1
 
-//GameConfig holds the configuration settings of the current game.
+//GameConfig holds the configuration settings of the current game. It
+//holds pointers in Economy, so compare configs with Equal.
 type GameConfig struct {
 	MaxTurns     uint8
 	NoBonusStars bool
@@ -10,6 +11,7 @@ type GameConfig struct {
 	BlueDice     bool
 	WarpDice     bool
 	EventsDice   bool
+	Economy      EconomyConfig
 }
 
 //Game is the structure that holds all game information.
@@ -21,9 +23,23 @@ type Game struct {
 	Turn          uint8
 	CurrentPlayer int
 	NextEvent     Event
+	//Phase is the part of the turn the game is in.
+	Phase Phase
 
 	//Every 10 passes, Koopa rewards 20 coins to the passing player.
 	KoopaPasses int
+
+	//Minigame is the minigame being played, if any.
+	Minigame MinigameTally
+
+	listeners *[]Listener
+}
+
+//MinigameTally is a minigame being played and the coins each player has
+//won or lost in it so far. Minigame is nil between minigames.
+type MinigameTally struct {
+	Minigame Response
+	Coins    [4]int
 }
 
 //Responses returns the valid responses for the next event.
@@ -43,6 +59,7 @@ func (g *Game) HandleEvent(r Response) {
 //dice in play. If there are, the next Event is set to pick a dice block.
 //Otherwise, the next Event is set to the normal dice block.
 func (g *Game) SetDiceBlock() {
+	g.Phase = RollPhase
 	if g.Turn != 0 && (g.Config.RedDice || g.Config.BlueDice || g.Config.WarpDice || g.Config.EventsDice) {
 		g.NextEvent = PickDiceBlock{g.CurrentPlayer, g.Config}
 	} else {
@@ -98,6 +115,24 @@ func InitializeGame(b Board, config GameConfig) *Game {
 	return g
 }
 
+//Clone returns a copy of g that can be played independently of g.
+//
+//The board topology (Chains, Links, the spaces' events and
+//StarSpaces.IndexToPosition) is never mutated by the engine, so it is
+//shared between g and the clone. All other state (Players, StarData
+//bitmasks, Turn, NextEvent and Board.Data) is copied. Events and board
+//data are plain values, except for board data implementing
+//ExtraBoardDataCloner, which is deep-copied through CloneData. Listeners
+//are not copied.
+func (g *Game) Clone() *Game {
+	c := *g
+	c.listeners = nil
+	if cloner, ok := c.Board.Data.(ExtraBoardDataCloner); ok {
+		c.Board.Data = cloner.CloneData()
+	}
+	return &c
+}
+
 //LastFiveTurns returns true if the game is in its' final 5 turns.
 func (g *Game) LastFiveTurns() bool {
 	return g.Config.MaxTurns-g.Turn <= 5
@@ -113,11 +148,19 @@ func (g *Game) AwardCoins(player, coins int, minigame bool) int {
 	coinsGiven := g.Players[player].Coins - coins0
 	if minigame {
 		g.Players[player].MinigameCoins += coins
+		if g.Minigame.Minigame != nil {
+			g.Minigame.Coins[player] += coinsGiven
+		}
 	}
 	g.Players[player].MaxCoins = max(
 		g.Players[player].MaxCoins,
 		g.Players[player].Coins,
 	)
+	if coinsGiven != 0 {
+		g.notify(CoinsChanged{
+			player, coinsGiven, g.Players[player].Coins, minigame,
+		})
+	}
 	return coinsGiven
 }
 
@@ -157,6 +200,7 @@ func (g *Game) CheckLinks(player, chain, moves int) (branch bool) {
 
 //ActivateSpace performs the action of a player landing on a space.
 func (g *Game) ActivateSpace(player int) {
+	g.Phase = LandPhase
 	//Activate Space
 	chains := *g.Board.Chains
 	playerPos := g.Players[player].CurrentSpace
@@ -170,6 +214,11 @@ func (g *Game) ActivateSpace(player int) {
 			g.Players[player].LastSpaceType = Blue
 		}
 	}
+	if g.Players[player].LastSpaceType != Invisible {
+		g.notify(SpaceLanded{
+			player, playerPos, g.Players[player].LastSpaceType,
+		})
+	}
 	//Perform space action
 	switch g.Players[player].LastSpaceType {
 	case Invisible:
@@ -177,18 +226,10 @@ func (g *Game) ActivateSpace(player int) {
 		curSpace.StoppingEvent(g, player)
 		g.ActivateSpace(player)
 	case Blue:
-		if g.LastFiveTurns() {
-			g.AwardCoins(player, 6, false)
-		} else {
-			g.AwardCoins(player, 3, false)
-		}
+		g.AwardCoins(player, g.BlueSpaceCoins(), false)
 		g.EndCharacterTurn()
 	case Red:
-		if g.LastFiveTurns() {
-			g.AwardCoins(player, -6, false)
-		} else {
-			g.AwardCoins(player, -3, false)
-		}
+		g.AwardCoins(player, -g.RedSpaceCoins(), false)
 		g.EndCharacterTurn()
 	case Mushroom:
 		g.NextEvent = MushroomEvent{player}
@@ -211,6 +252,7 @@ func (g *Game) ActivateSpace(player int) {
 //MovePlayer moves the player x many spaces through the board. It handles
 //branching and passing events.
 func (g *Game) MovePlayer(playerIdx, moves int) {
+	g.Phase = MovePhase
 	chains := *g.Board.Chains
 	playerPos := &g.Players[playerIdx].CurrentSpace
 	for moves > 0 {
@@ -225,6 +267,7 @@ func (g *Game) MovePlayer(playerIdx, moves int) {
 		switch curSpace.Type {
 		case Invisible:
 			if curSpace.PassingEvent != nil {
+				g.passSpace(playerIdx, curSpace.Type)
 				g.NextEvent = nil
 				moves = curSpace.PassingEvent(g, playerIdx, moves)
 				if g.NextEvent != nil {
@@ -232,21 +275,25 @@ func (g *Game) MovePlayer(playerIdx, moves int) {
 				}
 			} else {
 				moves--
+				if moves > 0 {
+					g.passSpace(playerIdx, curSpace.Type)
+				}
 			}
 		case Start:
+			g.passSpace(playerIdx, curSpace.Type)
 			if !g.Config.NoKoopa {
 				g.KoopaPasses++
 				if g.KoopaPasses%10 == 0 {
-					g.AwardCoins(playerIdx, 20, false)
+					g.AwardCoins(playerIdx, g.Economy().KoopaBonusCoins, false)
 				} else {
-					g.AwardCoins(playerIdx, 10, false)
+					g.AwardCoins(playerIdx, g.Economy().KoopaCoins, false)
 				}
 			}
 		case Star:
 			if *playerPos == g.StarSpaces.CurrentStarSpace &&
-				g.Players[playerIdx].Coins >= 20 {
-				g.Players[playerIdx].Stars++
-				g.AwardCoins(playerIdx, -20, false)
+				g.Players[playerIdx].Coins >= g.Economy().StarPrice {
+				g.AwardStars(playerIdx, 1)
+				g.AwardCoins(playerIdx, -g.Economy().StarPrice, false)
 				if g.StarSpaces.StarSpaceCount > 1 {
 					g.NextEvent = StarLocationEvent{
 						g.StarSpaces,
@@ -257,23 +304,32 @@ func (g *Game) MovePlayer(playerIdx, moves int) {
 				}
 			}
 			moves--
+			if moves > 0 {
+				g.passSpace(playerIdx, curSpace.Type)
+			}
 		case Boo:
+			g.passSpace(playerIdx, curSpace.Type)
 			if !g.Config.NoBoo {
 				booEvt := BooEvent{
 					playerIdx,
 					g.Players,
 					moves,
 					g.Players[playerIdx].Coins,
+					g.Economy().BooStarPrice,
 				}
-				if len(booEvt.Responses()) != 0 {
+				if booEvt.ResponseCount() != 0 {
 					g.NextEvent = booEvt
 					return
 				}
 			}
 		case BogusItem:
+			g.passSpace(playerIdx, curSpace.Type)
 			g.AwardCoins(playerIdx, -g.Board.BowserCoins, false)
 		default:
 			moves--
+			if moves > 0 {
+				g.passSpace(playerIdx, curSpace.Type)
+			}
 		}
 	}
 	curSpace := chains[playerPos.Chain][playerPos.Space]
@@ -286,6 +342,12 @@ func (g *Game) MovePlayer(playerIdx, moves int) {
 	g.ActivateSpace(playerIdx)
 }
 
+//passSpace notifies listeners that a player is passing over their
+//current space.
+func (g *Game) passSpace(player int, t SpaceType) {
+	g.notify(SpacePassed{player, g.Players[player].CurrentSpace, t})
+}
+
 //AwardBonusStars looks through the players' aggregated statistics to
 //award bonus stars.
 func (g *Game) AwardBonusStars() {
@@ -299,7 +361,7 @@ func (g *Game) AwardBonusStars() {
 	}
 	for i := 0; i < 4; i++ {
 		if g.Players[i].MaxCoins == maxCoins {
-			g.Players[i].Stars++
+			g.AwardStars(i, 1)
 		}
 	}
 
@@ -309,7 +371,7 @@ func (g *Game) AwardBonusStars() {
 	}
 	for i := 0; i < 4; i++ {
 		if g.Players[i].MinigameCoins == maxMinigameCoins {
-			g.Players[i].Stars++
+			g.AwardStars(i, 1)
 		}
 	}
 
@@ -319,7 +381,7 @@ func (g *Game) AwardBonusStars() {
 	}
 	for i := 0; i < 4; i++ {
 		if g.Players[i].HappeningCount == maxHappening {
-			g.Players[i].Stars++
+			g.AwardStars(i, 1)
 		}
 	}
 }
@@ -355,12 +417,16 @@ func (g *Game) Winners() []int {
 //end of the game, skipping player 0's turn in case of poison mushroom,
 //and setting the next diceblock.
 func (g *Game) EndGameTurn() {
+	g.endMinigame()
 	g.Turn++
 	if g.Turn == g.Config.MaxTurns {
 		g.AwardBonusStars()
 		//Game is over, no more events
 		g.NextEvent = nil
+		g.Phase = GameOverPhase
+		g.notify(TurnEnded{g.Turn})
 	} else {
+		g.notify(TurnEnded{g.Turn})
 		if g.Players[g.CurrentPlayer].SkipTurn {
 			g.Players[g.CurrentPlayer].SkipTurn = false
 			g.EndCharacterTurn()
@@ -372,6 +438,7 @@ func (g *Game) EndGameTurn() {
 
 //StartMinigamePrep starts preparation for the next end of turn minigame.
 func (g *Game) StartMinigamePrep() {
+	g.Phase = MinigamePhase
 	g.FindGreenPlayer()
 }
 
@@ -380,6 +447,8 @@ func (g *Game) StartMinigamePrep() {
 //poison mushroom, Starting minigame preparation if player 3 just
 //finished, and calling the board's specifc end of turn event.
 func (g *Game) EndCharacterTurn() {
+	g.endMinigame()
+	g.Phase = EndTurnPhase
 	if g.Board.EndCharacterTurn != nil {
 		g.Board.EndCharacterTurn.EndCharacterTurn(g, g.CurrentPlayer)
 	}
@@ -0,0 +1,61 @@
+package mp1
+
+import (
+	"reflect"
+	"testing"
+)
+
+type sliceBoardData struct {
+	Visits []int
+}
+
+func (s sliceBoardData) CloneData() ExtraBoardData {
+	visits := make([]int, len(s.Visits))
+	copy(visits, s.Visits)
+	return sliceBoardData{visits}
+}
+
+func TestCloneSharesTopology(t *testing.T) {
+	g := InitializeGame(multipleStarBoard, GameConfig{MaxTurns: 20})
+	c := g.Clone()
+	if c.Board.Chains != g.Board.Chains {
+		t.Errorf("Expected clone to share Chains")
+	}
+	if c.StarSpaces.IndexToPosition != g.StarSpaces.IndexToPosition {
+		t.Errorf("Expected clone to share IndexToPosition")
+	}
+	if !reflect.DeepEqual(g, c) {
+		t.Errorf("Expected clone:\n%#v\ngot:\n%#v", g, c)
+	}
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+	g := InitializeGame(multipleStarBoard, GameConfig{MaxTurns: 20})
+	g.NextEvent.Handle(ChainSpace{0, 2}, g)
+	g.Players[0].Coins = 20
+
+	c := g.Clone()
+	c.NextEvent.Handle(2, c) //Player 0 buys the star
+	c.NextEvent.Handle(ChainSpace{0, 4}, c)
+
+	StarsIs(0, 0, *g, "Original", t)
+	CoinsIs(20, 0, *g, "Original", t)
+	SpaceIs(ChainSpace{0, 0}, 0, *g, "Original", t)
+	IntIs(0, g.CurrentPlayer, "Original CurrentPlayer", t)
+	if g.StarSpaces.CurrentStarSpace != (ChainSpace{0, 2}) {
+		t.Errorf("Expected original star space: %#v, got: %#v",
+			ChainSpace{0, 2}, g.StarSpaces.CurrentStarSpace)
+	}
+	StarsIs(1, 0, *c, "Clone", t)
+}
+
+func TestCloneBoardData(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	g.Board.Data = sliceBoardData{[]int{1, 2, 3}}
+
+	c := g.Clone()
+	c.Board.Data.(sliceBoardData).Visits[0] = 10
+
+	got := g.Board.Data.(sliceBoardData).Visits[0]
+	IntIs(1, got, "Original Visits[0]", t)
+}
@@ -0,0 +1,172 @@
+package mp1
+
+import (
+	"math"
+	"reflect"
+)
+
+//HashVersion is the version of the state hashed by Game.Hash. It is
+//bumped whenever hashes change, so hashes kept outside a process should
+//be stored with the version they were computed with. Version 2 added
+//the game's Phase, version 3 the minigame being played and version 4
+//changed the hash of configs that set economy amounts.
+const HashVersion = 4
+
+//FNV-1a constants.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+//Hash returns a hash of the decision state of g: its Config, Players,
+//Turn, CurrentPlayer, Phase, KoopaPasses, Minigame, the star space data,
+//the board's Data and NextEvent, including the event's type. The board
+//itself and listeners are not hashed, so hashes should only be compared
+//between games on the same board.
+//
+//Hashes do not depend on pointers or map order, so a game, its clones and
+//the same game loaded in another process all have the same hash. They
+//only stay the same between releases with the same HashVersion. Games
+//with the same hash are not always equal, use Equal to tell them apart.
+func (g *Game) Hash() uint64 {
+	h := stateHash(fnvOffset64)
+	h.value(reflect.ValueOf(g.Config))
+	h.value(reflect.ValueOf(g.Players))
+	h.uint64(uint64(g.Turn))
+	h.uint64(uint64(g.CurrentPlayer))
+	h.uint64(uint64(g.Phase))
+	h.uint64(uint64(g.KoopaPasses))
+	h.any(reflect.ValueOf(&g.Minigame.Minigame).Elem())
+	h.value(reflect.ValueOf(g.Minigame.Coins))
+	h.uint64(uint64(g.StarSpaces.StarSpaceCount))
+	h.uint64(g.StarSpaces.AbsoluteVisited)
+	h.uint64(g.StarSpaces.RelativeVisited)
+	h.value(reflect.ValueOf(g.StarSpaces.CurrentStarSpace))
+	h.any(reflect.ValueOf(&g.Board.Data).Elem())
+	h.any(reflect.ValueOf(&g.NextEvent).Elem())
+	return uint64(h)
+}
+
+//Equal returns true if g and o have the same decision state, the state
+//hashed by Hash.
+func (g *Game) Equal(o *Game) bool {
+	a, b := g.StarSpaces, o.StarSpaces
+	return g.Config.Equal(o.Config) &&
+		g.Players == o.Players &&
+		g.Turn == o.Turn &&
+		g.CurrentPlayer == o.CurrentPlayer &&
+		g.Phase == o.Phase &&
+		g.KoopaPasses == o.KoopaPasses &&
+		g.Minigame == o.Minigame &&
+		a.StarSpaceCount == b.StarSpaceCount &&
+		a.AbsoluteVisited == b.AbsoluteVisited &&
+		a.RelativeVisited == b.RelativeVisited &&
+		a.CurrentStarSpace == b.CurrentStarSpace &&
+		reflect.DeepEqual(g.Board.Data, o.Board.Data) &&
+		reflect.DeepEqual(g.NextEvent, o.NextEvent)
+}
+
+//Equal returns true if c and o have the same settings and set the same
+//economy amounts.
+func (c GameConfig) Equal(o GameConfig) bool {
+	a, b := c.Economy, o.Economy
+	c.Economy, o.Economy = EconomyConfig{}, EconomyConfig{}
+	return c == o && reflect.DeepEqual(a, b)
+}
+
+//stateHash is an FNV-1a hash of values, written 8 bytes at a time.
+type stateHash uint64
+
+func (h *stateHash) uint64(v uint64) {
+	for i := 0; i < 8; i++ {
+		*h ^= stateHash(v & 0xff)
+		*h *= fnvPrime64
+		v >>= 8
+	}
+}
+
+func (h *stateHash) string(s string) {
+	h.uint64(uint64(len(s)))
+	for i := 0; i < len(s); i++ {
+		*h ^= stateHash(s[i])
+		*h *= fnvPrime64
+	}
+}
+
+//any hashes the dynamic type and value of the interface v. Types are
+//named by their registered name, or by their package path and name.
+//Unnamed types, which have no package path, use their type literal.
+func (h *stateHash) any(v reflect.Value) {
+	if v.IsNil() {
+		h.uint64(0)
+		return
+	}
+	v = v.Elem()
+	t := v.Type()
+	name, ok := typeNames[t]
+	if !ok && t.Name() != "" {
+		name = t.PkgPath() + "." + t.Name()
+	} else if !ok {
+		name = t.String()
+	}
+	h.string(name)
+	h.value(v)
+}
+
+//value hashes v field by field. Functions and channels are skipped, as
+//they have no value that is the same across processes.
+func (h *stateHash) value(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			h.uint64(1)
+		} else {
+			h.uint64(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		h.uint64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		h.uint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		h.uint64(math.Float64bits(v.Float()))
+	case reflect.String:
+		h.string(v.String())
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			h.value(v.Index(i))
+		}
+	case reflect.Slice:
+		h.uint64(uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			h.value(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			h.value(v.Field(i))
+		}
+	case reflect.Ptr:
+		if v.IsNil() {
+			h.uint64(0)
+			return
+		}
+		h.uint64(1)
+		h.value(v.Elem())
+	case reflect.Interface:
+		h.any(v)
+	case reflect.Map:
+		//Entries are summed so the order they are visited in does not
+		//matter.
+		var sum uint64
+		iter := v.MapRange()
+		for iter.Next() {
+			entry := stateHash(fnvOffset64)
+			entry.value(iter.Key())
+			entry.value(iter.Value())
+			sum += uint64(entry)
+		}
+		h.uint64(uint64(v.Len()))
+		h.uint64(sum)
+	}
+}
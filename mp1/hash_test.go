@@ -0,0 +1,78 @@
+package mp1
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestHashClone(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	g := InitializeGame(partyBoard, GameConfig{MaxTurns: 20, EventsDice: true})
+	g.Board.Data = sliceBoardData{[]int{1, 2}}
+	for g.NextEvent != nil {
+		c := g.Clone()
+		if c.Hash() != g.Hash() || !c.Equal(g) {
+			t.Fatalf("Expected clone of %#v to be equal", g.NextEvent)
+		}
+		g.HandleEvent(ResponseAt(g.NextEvent, r.Intn(ResponseCount(g.NextEvent))))
+	}
+}
+
+func TestHashChanges(t *testing.T) {
+	g := InitializeGame(partyBoard, GameConfig{MaxTurns: 20})
+	g.Board.Data = sliceBoardData{[]int{1, 2}}
+	g.NextEvent = NormalDiceBlock{Range{1, 10}, 0}
+	changes := []struct {
+		flavour string
+		change  func(g *Game)
+	}{
+		{"Coins", func(g *Game) { g.Players[1].Coins++ }},
+		{"Space", func(g *Game) { g.Players[0].CurrentSpace.Space++ }},
+		{"Turn", func(g *Game) { g.Turn++ }},
+		{"CurrentPlayer", func(g *Game) { g.CurrentPlayer = 2 }},
+		{"Phase", func(g *Game) { g.Phase = MinigamePhase }},
+		{"KoopaPasses", func(g *Game) { g.KoopaPasses++ }},
+		{"Visited", func(g *Game) { g.StarSpaces.RelativeVisited = 1 }},
+		{"Config", func(g *Game) { g.Config.NoBoo = true }},
+		{"Data", func(g *Game) { g.Board.Data = sliceBoardData{[]int{2, 1}} }},
+		{"No Data", func(g *Game) { g.Board.Data = nil }},
+		{"Event", func(g *Game) { g.NextEvent = PickDiceBlock{1, g.Config} }},
+		{"Event Type", func(g *Game) {
+			g.NextEvent = RedDiceBlock{Range{1, 10}, 0}
+		}},
+		{"No Event", func(g *Game) { g.NextEvent = nil }},
+	}
+	for _, tt := range changes {
+		c := g.Clone()
+		tt.change(c)
+		if c.Hash() == g.Hash() {
+			t.Errorf("%s: Expected hash to change", tt.flavour)
+		}
+		if c.Equal(g) {
+			t.Errorf("%s: Expected games to differ", tt.flavour)
+		}
+	}
+}
+
+func TestHashStable(t *testing.T) {
+	g := InitializeGame(partyBoard, GameConfig{MaxTurns: 20})
+	g.Players[0].Char = "Mario"
+	g.NextEvent = BooEvent{0, g.Players, 3, 10, 50}
+	//Hashes must not change between processes and builds. Changing them
+	//needs a new HashVersion, with its own expected hash.
+	if HashVersion != 4 {
+		t.Fatalf("Expected hash for HashVersion %d is unknown", HashVersion)
+	}
+	const expected = 0x873563f9586d2052
+	if got := g.Hash(); got != expected {
+		t.Errorf("Expected hash: %#x, got: %#x", uint64(expected), got)
+	}
+}
+
+func BenchmarkHash(b *testing.B) {
+	g := InitializeGame(partyBoard, GameConfig{MaxTurns: 20})
+	g.NextEvent = ChanceTime{Player: 1}
+	for n := 0; n < b.N; n++ {
+		g.Hash()
+	}
+}
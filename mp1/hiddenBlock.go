@@ -19,6 +19,16 @@ func (h HiddenBlockResponse) String() string {
 	return ""
 }
 
+func (h HiddenBlockResponse) Key() string {
+	switch h {
+	case HiddenBlockAppears:
+		return "HiddenBlockAppears"
+	case HiddenBlockNotThere:
+		return "HiddenBlockNotThere"
+	}
+	return ""
+}
+
 //HiddenBlockEvent holds the implementation for hidden blocks.
 type HiddenBlockEvent struct {
 	Player int
@@ -37,8 +47,18 @@ func (h HiddenBlockEvent) ControllingPlayer() int {
 	return CPU_PLAYER
 }
 
+var hiddenBlockResponses = []Response{HiddenBlockAppears, HiddenBlockNotThere}
+
 func (h HiddenBlockEvent) Responses() []Response {
-	return []Response{HiddenBlockAppears, HiddenBlockNotThere}
+	return responses(h)
+}
+
+func (h HiddenBlockEvent) ResponseCount() int {
+	return len(hiddenBlockResponses)
+}
+
+func (h HiddenBlockEvent) ResponseAt(i int) Response {
+	return hiddenBlockResponses[i]
 }
 
 //Handle sets the hidden block action to be taken depending on r. If r is
@@ -0,0 +1,85 @@
+package mp1
+
+//HistoryEntry is a single event handled through a GameHistory.
+type HistoryEntry struct {
+	Event    Event
+	Response Response
+
+	//Before is the state of the game before Response was handled.
+	Before *Game
+}
+
+//GameHistory wraps a Game, recording every event handled through it so
+//that responses can be undone and redone. The wrapped game is updated in
+//place, so pointers to it stay valid across Undo and Redo.
+type GameHistory struct {
+	game    *Game
+	entries []HistoryEntry
+	applied int
+}
+
+//NewGameHistory returns a GameHistory that records events handled on g.
+func NewGameHistory(g *Game) *GameHistory {
+	return &GameHistory{game: g}
+}
+
+//Game returns the game being recorded.
+func (h *GameHistory) Game() *Game {
+	return h.game
+}
+
+//HandleEvent handles the game's next event with r, then records the
+//event and r. Any undone entries are discarded. If the event panics,
+//nothing is recorded.
+func (h *GameHistory) HandleEvent(r Response) {
+	entry := HistoryEntry{
+		Event:    h.game.NextEvent,
+		Response: r,
+		Before:   h.game.Clone(),
+	}
+	h.game.HandleEvent(r)
+	h.entries = append(h.entries[:h.applied], entry)
+	h.applied++
+}
+
+//Undo restores the game to the state before the last handled response.
+//It returns false if there is nothing to undo.
+func (h *GameHistory) Undo() bool {
+	if h.applied == 0 {
+		return false
+	}
+	h.applied--
+	h.game.restore(h.entries[h.applied].Before)
+	return true
+}
+
+//Redo handles the last undone response again. It returns false if there
+//is nothing to redo.
+func (h *GameHistory) Redo() bool {
+	if h.applied == len(h.entries) {
+		return false
+	}
+	entry := h.entries[h.applied]
+	h.game.restore(entry.Before)
+	h.game.HandleEvent(entry.Response)
+	h.applied++
+	return true
+}
+
+//CanUndo returns true if there is a response to undo.
+func (h *GameHistory) CanUndo() bool {
+	return h.applied > 0
+}
+
+//CanRedo returns true if there is an undone response to redo.
+func (h *GameHistory) CanRedo() bool {
+	return h.applied < len(h.entries)
+}
+
+//History returns the entries that are currently applied to the game, in
+//the order they were handled.
+func (h *GameHistory) History() []HistoryEntry {
+	ret := make([]HistoryEntry, h.applied)
+	copy(ret, h.entries)
+	return ret
+}
@@ -0,0 +1,120 @@
+package mp1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHistoryUndoDiceRoll(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	h := NewGameHistory(g)
+	start := g.Clone()
+
+	h.HandleEvent(3) //Wrong roll
+	SpaceIs(ChainSpace{0, 3}, 0, *g, "Wrong roll", t)
+
+	if !h.Undo() {
+		t.Fatalf("Expected Undo to succeed")
+	}
+	if !reflect.DeepEqual(start, g) {
+		t.Errorf("Expected game:\n%#v\ngot:\n%#v", start, g)
+	}
+
+	h.HandleEvent(5) //Correct roll
+	SpaceIs(ChainSpace{0, 5}, 0, *g, "Correct roll", t)
+	if h.Redo() {
+		t.Errorf("Expected Redo to fail after a new response")
+	}
+	IntIs(1, len(h.History()), "History length", t)
+}
+
+func TestHistoryUndoRedoMinigame(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	for i := range g.Players {
+		g.Players[i].LastSpaceType = Blue
+	}
+	g.GetMinigame()
+	h := NewGameHistory(g)
+
+	h.HandleEvent(MinigameFFAMusicalMushroom)
+	h.HandleEvent(1) //Wrong winner
+	CoinsIs(20, 1, *g, "Wrong winner", t)
+	after := g.Clone()
+
+	h.Undo()
+	EventIs(MinigameFFAReward{}, g.NextEvent, "Undo", t)
+	CoinsIs(10, 1, *g, "Undo", t)
+
+	h.Redo()
+	if !reflect.DeepEqual(after, g) {
+		t.Errorf("Expected game:\n%#v\ngot:\n%#v", after, g)
+	}
+
+	h.Undo()
+	h.HandleEvent(2) //Correct winner
+	CoinsIs(10, 1, *g, "Correct winner", t)
+	CoinsIs(20, 2, *g, "Correct winner", t)
+
+	history := h.History()
+	IntIs(2, len(history), "History length", t)
+	EventIs(MinigameFFAReward{}, history[1].Event, "History", t)
+	if history[1].Response != 2 {
+		t.Errorf("Expected history response: 2, got: %#v",
+			history[1].Response)
+	}
+}
+
+func TestHistoryPanic(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	h := NewGameHistory(g)
+	h.HandleEvent(3)
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Expected the invalid response to panic")
+			}
+		}()
+		h.HandleEvent("Not a roll")
+	}()
+	IntIs(1, len(h.History()), "History length", t)
+	if h.CanRedo() {
+		t.Errorf("Expected nothing to redo")
+	}
+	h.Undo()
+	SpaceIs(ChainSpace{0, 0}, 0, *g, "Undo", t)
+}
+
+func TestHistoryUndoMinigameResult(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	for i := range g.Players {
+		g.Players[i].LastSpaceType = Blue
+	}
+	g.GetMinigame()
+	h := NewGameHistory(g)
+	h.HandleEvent(MinigameFFABurriedTreasure)
+	events := RecordEvents(g)
+
+	h.HandleEvent(0) //Wrong winner
+	h.Undo()
+	*events = nil
+	h.HandleEvent(1) //Correct winner
+	var played []GameEvent
+	for _, e := range *events {
+		if _, ok := e.(MinigamePlayed); ok {
+			played = append(played, e)
+		}
+	}
+	expected := []GameEvent{
+		MinigamePlayed{MinigameFFABurriedTreasure, [4]int{0, 10, 0, 0}},
+	}
+	if !reflect.DeepEqual(expected, played) {
+		t.Errorf("Expected events: %#v, got: %#v", expected, played)
+	}
+}
+
+func TestHistoryEmpty(t *testing.T) {
+	h := NewGameHistory(InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20}))
+	if h.Undo() || h.Redo() || h.CanUndo() || h.CanRedo() {
+		t.Errorf("Expected empty history to have nothing to undo/redo")
+	}
+}
@@ -0,0 +1,209 @@
+//Package httpapi serves mp1 game sessions over a JSON HTTP API.
+//
+//Routes:
+//
+//	GET    /boards                      names of the registered boards
+//	POST   /sessions                    create a session from a CreateRequest
+//	GET    /sessions/{id}               the session's State
+//	DELETE /sessions/{id}               delete the session
+//	POST   /sessions/{id}/responses     respond with a RespondRequest
+//	GET    /sessions/{id}/board         the session's BoardState
+//
+//Errors are returned as an ErrorResponse with a matching status code.
+package httpapi
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//Server is an http.Handler holding game sessions in memory. Boards must be
+//registered with mp1.RegisterBoard to be playable, importing mp1/board
+//registers every MP1 board.
+type Server struct {
+	mu       sync.Mutex
+	sessions map[string]*mp1.Game
+}
+
+//NewServer returns a Server without any sessions.
+func NewServer() *Server {
+	return &Server{sessions: map[string]*mp1.Game{}}
+}
+
+//CreateRequest is the body of POST /sessions.
+type CreateRequest struct {
+	Board  string
+	Config mp1.GameConfig
+	Chars  [4]string
+}
+
+//RespondRequest is the body of POST /sessions/{id}/responses. Index is
+//the index of the response in the options of State.Descriptor. If ID is
+//set, the response with that ID is used instead.
+type RespondRequest struct {
+	Index int
+	ID    string
+}
+
+//ErrorResponse is the body of every error.
+type ErrorResponse struct {
+	Error string
+}
+
+//ServeHTTP routes the request to its handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "boards":
+		if allowMethod(w, r, http.MethodGet) {
+			writeJSON(w, http.StatusOK, mp1.BoardNames())
+		}
+	case len(parts) == 1 && parts[0] == "sessions":
+		if allowMethod(w, r, http.MethodPost) {
+			s.create(w, r)
+		}
+	case len(parts) >= 2 && len(parts) <= 3 && parts[0] == "sessions":
+		s.session(w, r, parts[1], parts[2:])
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+//create handles POST /sessions.
+func (s *Server) create(w http.ResponseWriter, r *http.Request) {
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+	b, ok := mp1.LookupBoard(req.Board)
+	if !ok {
+		writeError(w, http.StatusBadRequest,
+			fmt.Sprintf("unknown board %q", req.Board))
+		return
+	}
+	if req.Config.MaxTurns == 0 {
+		writeError(w, http.StatusBadRequest, "Config.MaxTurns must be > 0")
+		return
+	}
+	g := mp1.InitializeGame(b, req.Config)
+	for i, char := range req.Chars {
+		if char == "" {
+			char = fmt.Sprintf("Player %d", i+1)
+		}
+		g.Players[i].Char = char
+	}
+	id, err := newID()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[id] = g
+	writeJSON(w, http.StatusCreated, NewState(id, g))
+}
+
+//session handles every route under /sessions/{id}.
+func (s *Server) session(w http.ResponseWriter, r *http.Request, id string, rest []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g, ok := s.sessions[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no session %q", id))
+		return
+	}
+	switch {
+	case len(rest) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, NewState(id, g))
+	case len(rest) == 0 && r.Method == http.MethodDelete:
+		delete(s.sessions, id)
+		w.WriteHeader(http.StatusNoContent)
+	case len(rest) == 0:
+		w.Header().Set("Allow", "GET, DELETE")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	case rest[0] == "responses":
+		if allowMethod(w, r, http.MethodPost) {
+			respond(w, r, id, g)
+		}
+	case rest[0] == "board":
+		if allowMethod(w, r, http.MethodGet) {
+			writeJSON(w, http.StatusOK, NewBoardState(g))
+		}
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+//respond handles POST /sessions/{id}/responses.
+func respond(w http.ResponseWriter, r *http.Request, id string, g *mp1.Game) {
+	var req RespondRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+	if g.NextEvent == nil {
+		writeError(w, http.StatusConflict, "game is over")
+		return
+	}
+	var err error
+	if req.ID != "" {
+		err = g.ApplyID(req.ID)
+	} else {
+		n := mp1.ResponseCount(g.NextEvent)
+		if req.Index < 0 || req.Index >= n {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf(
+				"index %d out of range [0, %d)", req.Index, n))
+			return
+		}
+		err = g.Apply(mp1.ResponseAt(g.NextEvent, req.Index))
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, mp1.ErrInvalidResponse) {
+			status = http.StatusBadRequest
+		}
+		writeError(w, status, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, NewState(id, g))
+}
+
+//allowMethod writes a 405 error and returns false if r's method is not
+//method.
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method == method {
+		return true
+	}
+	w.Header().Set("Allow", method)
+	writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, ErrorResponse{msg})
+}
+
+//newID returns a random session ID.
+func newID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
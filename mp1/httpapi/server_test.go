@@ -0,0 +1,167 @@
+package httpapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	_ "github.com/0xhexnumbers/partysim/mp1/board"
+)
+
+func Do(t *testing.T, ts *httptest.Server, method, path string, body interface{}, status int, out interface{}) {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		json.NewEncoder(&buf).Encode(body)
+	}
+	req, _ := http.NewRequest(method, ts.URL+path, &buf)
+	resp, err := ts.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != status {
+		var e ErrorResponse
+		json.NewDecoder(resp.Body).Decode(&e)
+		t.Fatalf("Expected %s %s status %d, got: %d (%s)",
+			method, path, status, resp.StatusCode, e.Error)
+	}
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("Expected JSON body, got: %v", err)
+		}
+	}
+}
+
+func CreateSession(t *testing.T, ts *httptest.Server) State {
+	var st State
+	Do(t, ts, http.MethodPost, "/sessions", CreateRequest{
+		Board:  "MRC",
+		Config: mp1.GameConfig{MaxTurns: 20},
+		Chars:  [4]string{"Mario", "Luigi", "Peach", "Yoshi"},
+	}, http.StatusCreated, &st)
+	return st
+}
+
+func TestBoards(t *testing.T) {
+	ts := httptest.NewServer(NewServer())
+	defer ts.Close()
+	var names []string
+	Do(t, ts, http.MethodGet, "/boards", nil, http.StatusOK, &names)
+	if len(names) != 8 {
+		t.Errorf("Expected 8 boards, got: %v", names)
+	}
+}
+
+func TestCreateAndRespond(t *testing.T) {
+	ts := httptest.NewServer(NewServer())
+	defer ts.Close()
+	st := CreateSession(t, ts)
+	if st.ID == "" || st.Board != "MRC" || st.Players[0].Char != "Mario" {
+		t.Errorf("Unexpected session: %#v", st)
+	}
+	d := st.Descriptor
+	if d == nil || d.Kind != "range" || len(d.Options) != 10 ||
+		d.ControllingPlayer != mp1.CPU_PLAYER {
+		t.Fatalf("Expected dice block, got: %#v", d)
+	}
+	if d.Question != "What did Mario roll?" {
+		t.Errorf("Unexpected question: %s", d.Question)
+	}
+
+	var got State
+	Do(t, ts, http.MethodGet, "/sessions/"+st.ID, nil, http.StatusOK, &got)
+	if got.Descriptor == nil || got.Descriptor.Question != d.Question {
+		t.Errorf("Expected same state, got: %#v", got)
+	}
+
+	Do(t, ts, http.MethodPost, "/sessions/"+st.ID+"/responses",
+		RespondRequest{Index: 2}, http.StatusOK, &got)
+	if got.Players[0].CurrentSpace == st.Players[0].CurrentSpace {
+		t.Errorf("Expected Mario to move, got: %#v", got.Players[0])
+	}
+}
+
+func TestRespondByID(t *testing.T) {
+	ts := httptest.NewServer(NewServer())
+	defer ts.Close()
+	st := CreateSession(t, ts)
+	d := st.Descriptor
+	if d == nil || d.Kind != "range" || d.Range == nil || d.Range.Max != 10 {
+		t.Fatalf("Expected dice block descriptor, got: %#v", d)
+	}
+	if d.Options[3].ID != "int:4" {
+		t.Errorf("Expected ID int:4, got: %#v", d.Options[3])
+	}
+
+	var got State
+	Do(t, ts, http.MethodPost, "/sessions/"+st.ID+"/responses",
+		RespondRequest{ID: "int:4"}, http.StatusOK, &got)
+	if got.Players[0].CurrentSpace == st.Players[0].CurrentSpace {
+		t.Errorf("Expected Mario to move, got: %#v", got.Players[0])
+	}
+	Do(t, ts, http.MethodPost, "/sessions/"+got.ID+"/responses",
+		RespondRequest{ID: "int:11"}, http.StatusBadRequest, nil)
+}
+
+func TestPlayToEnd(t *testing.T) {
+	ts := httptest.NewServer(NewServer())
+	defer ts.Close()
+	var st State
+	Do(t, ts, http.MethodPost, "/sessions", CreateRequest{
+		Board:  "YTI",
+		Config: mp1.GameConfig{MaxTurns: 1},
+	}, http.StatusCreated, &st)
+	for i := 0; !st.GameOver; i++ {
+		if i > 1000 {
+			t.Fatalf("Expected game to end")
+		}
+		Do(t, ts, http.MethodPost, "/sessions/"+st.ID+"/responses",
+			RespondRequest{Index: len(st.Descriptor.Options) - 1},
+			http.StatusOK, &st)
+	}
+	if len(st.Winners) == 0 || st.Descriptor != nil ||
+		st.Phase != "game_over" {
+		t.Errorf("Expected a finished game with winners, got: %#v", st)
+	}
+	Do(t, ts, http.MethodPost, "/sessions/"+st.ID+"/responses",
+		RespondRequest{Index: 0}, http.StatusConflict, nil)
+}
+
+func TestBoardState(t *testing.T) {
+	ts := httptest.NewServer(NewServer())
+	defer ts.Close()
+	st := CreateSession(t, ts)
+	var bs BoardState
+	Do(t, ts, http.MethodGet, "/sessions/"+st.ID+"/board", nil,
+		http.StatusOK, &bs)
+	if len(bs.Chains) != 5 || len(bs.Links) != 4 || bs.Data == nil {
+		t.Errorf("Unexpected board state: %#v", bs)
+	}
+	if bs.Chains[0][1].Type != "Start" {
+		t.Errorf("Expected start space, got: %#v", bs.Chains[0][1])
+	}
+}
+
+func TestErrors(t *testing.T) {
+	ts := httptest.NewServer(NewServer())
+	defer ts.Close()
+	Do(t, ts, http.MethodPost, "/sessions", CreateRequest{
+		Board:  "Nope",
+		Config: mp1.GameConfig{MaxTurns: 20},
+	}, http.StatusBadRequest, nil)
+	Do(t, ts, http.MethodPost, "/sessions", CreateRequest{Board: "MRC"},
+		http.StatusBadRequest, nil)
+	Do(t, ts, http.MethodGet, "/sessions/nope", nil, http.StatusNotFound, nil)
+	Do(t, ts, http.MethodGet, "/sessions", nil, http.StatusMethodNotAllowed, nil)
+
+	st := CreateSession(t, ts)
+	Do(t, ts, http.MethodPost, "/sessions/"+st.ID+"/responses",
+		RespondRequest{Index: 10}, http.StatusBadRequest, nil)
+	Do(t, ts, http.MethodDelete, "/sessions/"+st.ID, nil,
+		http.StatusNoContent, nil)
+	Do(t, ts, http.MethodGet, "/sessions/"+st.ID, nil, http.StatusNotFound, nil)
+}
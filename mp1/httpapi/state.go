@@ -0,0 +1,90 @@
+package httpapi
+
+import "github.com/0xhexnumbers/partysim/mp1"
+
+//State is the JSON view of a session.
+type State struct {
+	ID            string
+	Board         string
+	Turn          uint8
+	MaxTurns      uint8
+	CurrentPlayer int
+	//Phase is the name of the game's mp1.Phase, such as roll or minigame.
+	Phase   string
+	Players [4]mp1.Player
+
+	//GameOver is true once the game has ended. Winners is only set then.
+	GameOver bool
+	Winners  []int
+
+	//Descriptor describes the next event, its question, controlling
+	//player and responses. ControllingPlayer is mp1.CPU_PLAYER (4) for
+	//chance events. It is nil once the game has ended.
+	Descriptor *mp1.EventDescriptor
+}
+
+//NewState returns the State of the game g of session id.
+func NewState(id string, g *mp1.Game) State {
+	st := State{
+		ID:            id,
+		Turn:          g.Turn,
+		MaxTurns:      g.Config.MaxTurns,
+		CurrentPlayer: g.CurrentPlayer,
+		Phase:         g.Phase.String(),
+		Players:       g.Players,
+	}
+	st.Board, _ = mp1.BoardName(g.Board)
+	e := g.NextEvent
+	if e == nil {
+		st.GameOver = true
+		st.Winners = g.Winners()
+		return st
+	}
+	d := mp1.Describe(g, e)
+	st.Descriptor = &d
+	return st
+}
+
+//BoardState is the JSON view of a session's board.
+type BoardState struct {
+	Chains           [][]SpaceView
+	Links            map[int][]mp1.ChainSpace
+	StarSpaces       []mp1.ChainSpace
+	CurrentStarSpace mp1.ChainSpace
+	BowserCoins      int
+	//Data is the board specific data, such as gate or toggle states.
+	Data mp1.ExtraBoardData
+}
+
+//SpaceView is a space of the board.
+type SpaceView struct {
+	Type        string
+	HiddenBlock bool
+}
+
+//NewBoardState returns the BoardState of g.
+func NewBoardState(g *mp1.Game) BoardState {
+	bs := BoardState{
+		Links:            map[int][]mp1.ChainSpace{},
+		StarSpaces:       []mp1.ChainSpace{},
+		CurrentStarSpace: g.StarSpaces.CurrentStarSpace,
+		BowserCoins:      g.BowserCoins,
+		Data:             g.Data,
+	}
+	for _, chain := range *g.Chains {
+		spaces := make([]SpaceView, len(chain))
+		for i, space := range chain {
+			spaces[i] = SpaceView{space.Type.String(), space.HiddenBlock}
+		}
+		bs.Chains = append(bs.Chains, spaces)
+	}
+	if g.Links != nil {
+		for chain, links := range *g.Links {
+			bs.Links[chain] = *links
+		}
+	}
+	if g.StarSpaces.IndexToPosition != nil {
+		bs.StarSpaces = *g.StarSpaces.IndexToPosition
+	}
+	return bs
+}
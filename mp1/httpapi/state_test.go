@@ -0,0 +1,26 @@
+package httpapi
+
+import (
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+func TestNewStateResponses(t *testing.T) {
+	g := mp1.InitializeGame(mp1.Board{Chains: &[]mp1.Chain{{{Type: mp1.Start}}}},
+		mp1.GameConfig{MaxTurns: 20})
+	g.NextEvent = mp1.HiddenBlockEvent{Player: 0}
+	st := NewState("id", g)
+	d := st.Descriptor
+	if d == nil || d.Event != "HiddenBlockEvent" || len(d.Options) != 2 {
+		t.Fatalf("Expected HiddenBlockEvent descriptor, got: %#v", d)
+	}
+	expected := mp1.ResponseOption{
+		ID:    `HiddenBlockResponse:"HiddenBlockNotThere"`,
+		Label: "There is no hidden block",
+		Value: mp1.HiddenBlockNotThere,
+	}
+	if d.Options[1] != expected {
+		t.Errorf("Expected %#v, got: %#v", expected, d.Options[1])
+	}
+}
@@ -0,0 +1,30 @@
+package mp1
+
+import "fmt"
+
+//CheckInvariants returns an error describing the first broken invariant
+//of g, or nil if every invariant holds: no player has negative coins or
+//stars, every player's CurrentSpace is a space of the board, CurrentPlayer
+//is a player and Turn does not exceed Config.MaxTurns.
+func (g *Game) CheckInvariants() error {
+	for i, p := range g.Players {
+		if p.Coins < 0 {
+			return fmt.Errorf("mp1: player %d has %d coins", i+1, p.Coins)
+		}
+		if p.Stars < 0 {
+			return fmt.Errorf("mp1: player %d has %d stars", i+1, p.Stars)
+		}
+		if !g.Board.has(p.CurrentSpace) {
+			return fmt.Errorf("mp1: player %d is on %v, which is not a space",
+				i+1, p.CurrentSpace)
+		}
+	}
+	if g.CurrentPlayer < 0 || g.CurrentPlayer >= len(g.Players) {
+		return fmt.Errorf("mp1: current player is %d", g.CurrentPlayer)
+	}
+	if g.Turn > g.Config.MaxTurns {
+		return fmt.Errorf("mp1: turn %d is past the last turn %d",
+			g.Turn, g.Config.MaxTurns)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package mp1
+
+import "sort"
+
+//maxMoveEvents is the most events a single move is followed through
+//before Landings drops it, such as a player shot from cannon to cannon
+//without using up a move.
+const maxMoveEvents = 32
+
+//Decision is a response given to an event while a player was moving.
+type Decision struct {
+	Event    Event
+	Response Response
+}
+
+//Landing is a space a player can end their movement on, with the
+//decisions made on the way there, in order. Decisions include those made
+//by CPU_PLAYER, such as where a warp sends the player.
+type Landing struct {
+	Space     ChainSpace
+	Decisions []Decision
+}
+
+//RollLandings are the spaces a player can end their movement on with a
+//roll.
+type RollLandings struct {
+	Roll     int
+	Landings []Landing
+	//CPU is true if an event controlled by CPU_PLAYER, and not the
+	//player, decides which of the Landings the player ends on.
+	CPU bool
+}
+
+//Landings returns the spaces player can end their movement on for every
+//roll from 1 to 10, in order of the roll.
+func (g *Game) Landings(player int) []RollLandings {
+	ret := make([]RollLandings, 10)
+	for i := range ret {
+		ret[i] = g.LandingsFor(player, i+1)
+	}
+	return ret
+}
+
+//LandingsFor returns the spaces player can end their movement on if they
+//move roll spaces from their current space. Every response to the events
+//met on the way, such as branches, is tried on a clone of g, so g is not
+//changed. Passing events that need no response are run as in
+//MovePlayer.
+//
+//Movement ends on the first space the player lands on, or the space
+//waiting for a HiddenBlockEvent. Moves needing more than 32 decisions
+//are dropped. Each Landing lists the fewest decisions found to reach it,
+//and Landings are sorted by space.
+func (g *Game) LandingsFor(player, roll int) RollLandings {
+	start := g.Clone()
+	start.NextEvent = nil
+	l := landingSearch{
+		player: player,
+		turn:   g.Turn,
+		cur:    g.CurrentPlayer,
+		found:  map[ChainSpace]*Landing{},
+		memo:   map[moveState]*moveResult{},
+	}
+	var landed *ChainSpace
+	l.watch(start, &landed)
+	start.MovePlayer(player, roll)
+
+	ret := RollLandings{Roll: roll}
+	_, ret.CPU = l.follow(start, landed, nil, 0)
+	for _, landing := range l.found {
+		ret.Landings = append(ret.Landings, *landing)
+	}
+	sort.Slice(ret.Landings, func(i, j int) bool {
+		a, b := ret.Landings[i].Space, ret.Landings[j].Space
+		if a.Chain != b.Chain {
+			return a.Chain < b.Chain
+		}
+		return a.Space < b.Space
+	})
+	return ret
+}
+
+//landingSearch holds the state of LandingsFor.
+type landingSearch struct {
+	player int
+	turn   uint8
+	cur    int
+	found  map[ChainSpace]*Landing
+	memo   map[moveState]*moveResult
+}
+
+//moveState is what the rest of a move depends on, so moves reaching the
+//same moveState are only followed once. Warps that use up no moves, such
+//as WBC's cannons, would otherwise be followed through every order.
+type moveState struct {
+	event Event
+	space ChainSpace
+	coins int
+	stars int
+	data  ExtraBoardData
+}
+
+//moveResult is the result of following a moveState.
+type moveResult struct {
+	ends map[ChainSpace]bool
+	cpu  bool
+}
+
+//state returns the moveState of g, or false if its event or board data
+//can not be compared.
+func (l *landingSearch) state(g *Game) (moveState, bool) {
+	if !isComparable(g.NextEvent) || !isComparable(g.Board.Data) {
+		return moveState{}, false
+	}
+	p := g.Players[l.player]
+	return moveState{
+		g.NextEvent, p.CurrentSpace, p.Coins, p.Stars, g.Board.Data,
+	}, true
+}
+
+//isComparable returns true if v can be used as a map key.
+func isComparable(v interface{}) (ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return v == v
+}
+
+//watch sets *landed to the space the player lands on in g.
+func (l *landingSearch) watch(g *Game, landed **ChainSpace) {
+	g.AddListener(ListenerFunc(func(g *Game, e GameEvent) {
+		if sl, ok := e.(SpaceLanded); ok && sl.Player == l.player &&
+			*landed == nil {
+			space := sl.Space
+			*landed = &space
+		}
+	}))
+}
+
+//follow continues the movement in g, which landed on landed if it is not
+//nil, and records where it ends. It returns the spaces the movement can
+//end on and whether an event controlled by CPU_PLAYER decides between
+//them.
+func (l *landingSearch) follow(g *Game, landed *ChainSpace, path []Decision,
+	depth int) (ends map[ChainSpace]bool, cpu bool) {
+	if landed == nil && depth >= maxMoveEvents {
+		return nil, false //Most likely going around in circles
+	}
+	_, hidden := g.NextEvent.(HiddenBlockEvent)
+	if landed == nil && (hidden || g.NextEvent == nil ||
+		g.Turn != l.turn || g.CurrentPlayer != l.cur) {
+		//The player is waiting for a hidden block or their movement was
+		//ended by an event.
+		space := g.Players[l.player].CurrentSpace
+		landed = &space
+	}
+	if landed != nil {
+		if prev, ok := l.found[*landed]; !ok ||
+			len(path) < len(prev.Decisions) {
+			l.found[*landed] = &Landing{*landed, path}
+		}
+		return map[ChainSpace]bool{*landed: true}, false
+	}
+
+	key, ok := l.state(g)
+	if ok {
+		if res, seen := l.memo[key]; seen {
+			return res.ends, res.cpu
+		}
+		//Moves looping back to key end where they started
+		l.memo[key] = &moveResult{}
+	}
+	e := g.NextEvent
+	ends = map[ChainSpace]bool{}
+	var first map[ChainSpace]bool
+	for i, n := 0, ResponseCount(e); i < n; i++ {
+		r := ResponseAt(e, i)
+		c := g.Clone()
+		var next *ChainSpace
+		l.watch(c, &next)
+		c.HandleEvent(r)
+		decisions := append(path[:len(path):len(path)], Decision{e, r})
+		childEnds, childCPU := l.follow(c, next, decisions, depth+1)
+		cpu = cpu || childCPU
+		for space := range childEnds {
+			ends[space] = true
+		}
+		if e.ControllingPlayer() != CPU_PLAYER {
+			continue
+		}
+		if i == 0 {
+			first = childEnds
+		} else if !sameSpaces(first, childEnds) {
+			cpu = true
+		}
+	}
+	if ok {
+		l.memo[key] = &moveResult{ends, cpu}
+	}
+	return ends, cpu
+}
+
+//sameSpaces returns true if a and b hold the same spaces.
+func sameSpaces(a, b map[ChainSpace]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for space := range a {
+		if !b[space] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+package mp1
+
+import (
+	"reflect"
+	"testing"
+)
+
+//cpuBranch is a BranchEvent decided by the CPU, like a random warp.
+type cpuBranch struct{ BranchEvent }
+
+func (c cpuBranch) ControllingPlayer() int {
+	return CPU_PLAYER
+}
+
+//cpuFork sends players passing it down one of links, picked by the CPU.
+func cpuFork(links ...ChainSpace) func(g *Game, player, moves int) int {
+	return func(g *Game, player, moves int) int {
+		g.NextEvent = cpuBranch{BranchEvent{player, moves, &links}}
+		return moves
+	}
+}
+
+//ForkBoard returns a board whose first chain forks into two chains of
+//two spaces, both leading back to Start.
+func ForkBoard() Board {
+	return Board{
+		Chains: &[]Chain{
+			{{Type: Start}, {Type: Blue}, {Type: Blue}},
+			{{Type: Blue}, {Type: Red}},
+			{{Type: Red}, {Type: Blue}},
+		},
+		Links: &map[int]*[]ChainSpace{
+			0: {{1, 0}, {2, 0}},
+			1: {{0, 0}},
+			2: {{0, 0}},
+		},
+	}
+}
+
+func LandingsAre(expected []ChainSpace, rl RollLandings, t *testing.T) {
+	t.Helper()
+	var got []ChainSpace
+	for _, l := range rl.Landings {
+		got = append(got, l.Space)
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("Expected roll %d landings: %v, got: %v",
+			rl.Roll, expected, got)
+	}
+}
+
+func TestLandings(t *testing.T) {
+	g := InitializeGame(ForkBoard(), GameConfig{MaxTurns: 20})
+	before := *g.Clone()
+	rolls := g.Landings(0)
+	if len(rolls) != 10 {
+		t.Fatalf("Expected 10 rolls, got: %d", len(rolls))
+	}
+	LandingsAre([]ChainSpace{{0, 2}}, rolls[1], t)
+	if d := rolls[1].Landings[0].Decisions; len(d) != 0 {
+		t.Errorf("Expected no decisions for roll 2, got: %v", d)
+	}
+
+	LandingsAre([]ChainSpace{{1, 0}, {2, 0}}, rolls[2], t)
+	expected := []Decision{{
+		BranchEvent{0, 1, (*g.Links)[0]}, ChainSpace{2, 0},
+	}}
+	if d := rolls[2].Landings[1].Decisions; !reflect.DeepEqual(expected, d) {
+		t.Errorf("Expected decisions: %v, got: %v", expected, d)
+	}
+
+	//Both branches lead back around to (0, 1)
+	LandingsAre([]ChainSpace{{0, 1}}, rolls[4], t)
+	for _, rl := range rolls {
+		if rl.CPU {
+			t.Errorf("Expected roll %d to not depend on the CPU", rl.Roll)
+		}
+	}
+	if !reflect.DeepEqual(before, *g) {
+		t.Errorf("Expected Landings to leave the game unchanged")
+	}
+}
+
+func TestLandingsCPU(t *testing.T) {
+	b := ForkBoard()
+	(*b.Chains)[0][2] = Space{
+		Type:         Invisible,
+		PassingEvent: cpuFork(ChainSpace{1, 0}, ChainSpace{2, 0}),
+	}
+	g := InitializeGame(b, GameConfig{MaxTurns: 20})
+	rl := g.LandingsFor(0, 2)
+	LandingsAre([]ChainSpace{{1, 0}, {2, 0}}, rl, t)
+	if !rl.CPU {
+		t.Errorf("Expected the CPU to decide where roll 2 lands")
+	}
+	if rl := g.LandingsFor(0, 1); rl.CPU {
+		t.Errorf("Expected roll 1 to not depend on the CPU")
+	}
+
+	//The CPU decides nothing if both of its responses lead to (1, 0)
+	(*b.Chains)[0][2].PassingEvent = cpuFork(ChainSpace{1, 0}, ChainSpace{1, 0})
+	g.Board.Data = sliceBoardData{[]int{1}}
+	rl = g.LandingsFor(0, 2)
+	LandingsAre([]ChainSpace{{1, 0}}, rl, t)
+	if rl.CPU {
+		t.Errorf("Expected roll 2 to not depend on the CPU")
+	}
+}
+
+func TestLandingsHiddenBlock(t *testing.T) {
+	g := InitializeGame(ForkBoard(), GameConfig{MaxTurns: 20, EventsDice: true})
+	rl := g.LandingsFor(0, 1)
+	LandingsAre([]ChainSpace{{0, 1}}, rl, t)
+	if rl.CPU {
+		t.Errorf("Expected the hidden block to not be followed")
+	}
+}
@@ -0,0 +1,133 @@
+package mp1
+
+//GameEvent is a change in game state sent to every Listener registered on
+//a Game. It is one of CoinsChanged, StarsChanged, SpaceLanded,
+//SpacePassed, MinigamePlayed or TurnEnded.
+type GameEvent interface{}
+
+//CoinsChanged is sent whenever a player's coin count changes.
+type CoinsChanged struct {
+	Player int
+	Delta  int
+	Coins  int
+	//Minigame is true if the coins were won or lost in a minigame.
+	Minigame bool
+}
+
+//StarsChanged is sent whenever a player's star count changes, including
+//bonus stars at the end of the game.
+type StarsChanged struct {
+	Player int
+	Delta  int
+	Stars  int
+}
+
+//SpaceLanded is sent when a player stops on a space, before the space's
+//action is performed. Type is the space type that will be activated, so
+//invisible spaces report the type set by their StoppingEvent.
+type SpaceLanded struct {
+	Player int
+	Space  ChainSpace
+	Type   SpaceType
+}
+
+//SpacePassed is sent for every space a player moves over without
+//stopping on it, such as Start (Koopa) and Boo spaces.
+type SpacePassed struct {
+	Player int
+	Space  ChainSpace
+	Type   SpaceType
+}
+
+//MinigamePlayed is sent when a minigame is over, after the CoinsChanged
+//events of its rewards. Minigame is a MinigameFFAGame, Minigame2V2Game,
+//Minigame1V3Game, Minigame1PGame or, for Bowser's minigames, a
+//BowserResponse. Coins are the coins each player won or lost in it.
+type MinigamePlayed struct {
+	Minigame Response
+	Coins    [4]int
+}
+
+//TurnEnded is sent after every game turn. Turn is the number of turns
+//played so far; the game is over when it equals Config.MaxTurns.
+type TurnEnded struct {
+	Turn uint8
+}
+
+//Listener receives every GameEvent of the games it is registered on.
+//Notify is called after the change has been applied to g.
+type Listener interface {
+	Notify(g *Game, e GameEvent)
+}
+
+//ListenerFunc adapts a function to a Listener.
+type ListenerFunc func(g *Game, e GameEvent)
+
+//Notify calls f(g, e).
+func (f ListenerFunc) Notify(g *Game, e GameEvent) {
+	f(g, e)
+}
+
+//AddListener registers l to receive the game's events. Listeners are not
+//saved, cloned or compared with the rest of the game, but are kept by
+//GameHistory and Apply when they restore a previous state.
+func (g *Game) AddListener(l Listener) {
+	if g.listeners == nil {
+		g.listeners = &[]Listener{}
+	}
+	*g.listeners = append(*g.listeners, l)
+}
+
+//notify sends e to every registered listener.
+func (g *Game) notify(e GameEvent) {
+	if g.listeners == nil {
+		return
+	}
+	for _, l := range *g.listeners {
+		l.Notify(g, e)
+	}
+}
+
+//startMinigame sets minigame as the minigame being played, to be sent as
+//a MinigamePlayed event by endMinigame.
+func (g *Game) startMinigame(minigame Response) {
+	g.Minigame = MinigameTally{Minigame: minigame}
+}
+
+//endMinigame sends the MinigamePlayed event of the minigame being played,
+//if there is one.
+func (g *Game) endMinigame() {
+	if g.Minigame.Minigame == nil {
+		return
+	}
+	e := MinigamePlayed(g.Minigame)
+	g.Minigame = MinigameTally{}
+	g.notify(e)
+}
+
+//restore sets g to a copy of state, keeping g's listeners.
+func (g *Game) restore(state *Game) {
+	listeners := g.listeners
+	*g = *state.Clone()
+	g.listeners = listeners
+}
+
+//AwardStars gives a player stars, or takes them away if stars is negative.
+func (g *Game) AwardStars(player, stars int) {
+	if stars == 0 {
+		return
+	}
+	g.Players[player].Stars += stars
+	g.notify(StarsChanged{player, stars, g.Players[player].Stars})
+}
+
+//setCoins sets a player's coins outside of AwardCoins, such as when coins
+//are swapped or evened out.
+func (g *Game) setCoins(player, coins int) {
+	delta := coins - g.Players[player].Coins
+	if delta == 0 {
+		return
+	}
+	g.Players[player].Coins = coins
+	g.notify(CoinsChanged{player, delta, coins, false})
+}
@@ -0,0 +1,135 @@
+package mp1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func RecordEvents(g *Game) *[]GameEvent {
+	events := &[]GameEvent{}
+	g.AddListener(ListenerFunc(func(g *Game, e GameEvent) {
+		*events = append(*events, e)
+	}))
+	return events
+}
+
+func TestListenerMovePlayer(t *testing.T) {
+	b := MakeRepeatedBoard(Blue, 3)
+	b.Links = &map[int]*[]ChainSpace{
+		0: {{0, 0}},
+	}
+	g := InitializeGame(b, GameConfig{MaxTurns: 20})
+	events := RecordEvents(g)
+	g.MovePlayer(0, 4)
+	expected := []GameEvent{
+		SpacePassed{0, ChainSpace{0, 1}, Blue},
+		SpacePassed{0, ChainSpace{0, 2}, Blue},
+		SpacePassed{0, ChainSpace{0, 3}, Blue},
+		SpacePassed{0, ChainSpace{0, 0}, Start},
+		CoinsChanged{0, 10, 20, false},
+		SpaceLanded{0, ChainSpace{0, 1}, Blue},
+		CoinsChanged{0, 3, 23, false},
+	}
+	if !reflect.DeepEqual(expected, *events) {
+		t.Errorf("Expected events: %#v, got: %#v", expected, *events)
+	}
+}
+
+func TestListenerNoCoinChange(t *testing.T) {
+	g := InitializeGame(MakeSimpleBoard(Red), GameConfig{MaxTurns: 20})
+	g.Players[0].Coins = 0
+	events := RecordEvents(g)
+	g.AwardCoins(0, -3, false)
+	if len(*events) != 0 {
+		t.Errorf("Expected no events, got: %#v", *events)
+	}
+}
+
+func TestListenerSwapStars(t *testing.T) {
+	g := InitializeGame(ChanceBoard, GameConfig{MaxTurns: 20})
+	g.Players[1].Stars = 3
+	events := RecordEvents(g)
+	g.NextEvent = ChanceTime{Player: 0}
+	g.HandleEvent(ChanceTimeResponse{CTBLeft, 0})
+	g.HandleEvent(ChanceTimeResponse{CTBRight, 1})
+	g.HandleEvent(ChanceTimeResponse{CTBMiddle, int(SwapStars)})
+	expected := []GameEvent{
+		StarsChanged{0, 3, 3},
+		StarsChanged{1, -3, 0},
+	}
+	if !reflect.DeepEqual(expected, *events) {
+		t.Errorf("Expected events: %#v, got: %#v", expected, *events)
+	}
+}
+
+func TestListenerMinigame(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	events := RecordEvents(g)
+	g.NextEvent = MinigameFFASelector{}
+	g.HandleEvent(MinigameFFABurriedTreasure)
+	if len(*events) != 0 {
+		t.Errorf("Expected no events before the result, got: %#v", *events)
+	}
+	g.HandleEvent(1)
+	expected := []GameEvent{
+		CoinsChanged{1, 10, 20, true},
+		MinigamePlayed{MinigameFFABurriedTreasure, [4]int{0, 10, 0, 0}},
+		TurnEnded{1},
+	}
+	if !reflect.DeepEqual(expected, *events) {
+		t.Errorf("Expected events: %#v, got: %#v", expected, *events)
+	}
+}
+
+func TestListenerBowserMinigame(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 20})
+	g.Players[1].Coins = 50
+	events := RecordEvents(g)
+	g.NextEvent = BowserEvent{0}
+	g.HandleEvent(BowsersTugoWar)
+	g.HandleEvent(BTWDraw)
+	expected := MinigamePlayed{BowsersTugoWar, [4]int{-10, -30, -10, -10}}
+	if len(*events) != 5 || (*events)[4] != expected {
+		t.Errorf("Expected %#v after the coins, got: %#v",
+			expected, *events)
+	}
+}
+
+func TestListenerGameOver(t *testing.T) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 1})
+	events := RecordEvents(g)
+	g.EndGameTurn()
+	stars := 0
+	for _, e := range *events {
+		if _, ok := e.(StarsChanged); ok {
+			stars++
+		}
+	}
+	IntIs(12, stars, "bonus StarsChanged events", t)
+	last := (*events)[len(*events)-1]
+	if last != (TurnEnded{1}) {
+		t.Errorf("Expected last event: TurnEnded{1}, got: %#v", last)
+	}
+}
+
+func TestListenersKeptOnRestore(t *testing.T) {
+	g := InitializeGame(MakeSimpleBoard(Blue), GameConfig{MaxTurns: 20})
+	events := RecordEvents(g)
+	if c := g.Clone(); c.listeners != nil {
+		t.Errorf("Expected clone without listeners")
+	}
+	h := NewGameHistory(g)
+	h.HandleEvent(1)
+	h.Undo()
+	*events = nil
+	h.Redo()
+	if len(*events) == 0 {
+		t.Errorf("Expected events after redo")
+	}
+	*events = nil
+	if err := g.Apply(99); err == nil {
+		t.Fatalf("Expected invalid response error")
+	}
+	g.AwardCoins(0, 1, false)
+	IntIs(1, len(*events), "events after failed apply", t)
+}
@@ -34,25 +34,41 @@ var MinigameFFAPlayers = DrawableFFAPlayers[:4]
 
 //Responses returns a slice of ints from [0, 4]
 func (d DrawableFFAReward) Responses() []Response {
-	return DrawableFFAPlayers
+	return responses(d)
+}
+
+func (d DrawableFFAReward) ResponseCount() int {
+	return len(DrawableFFAPlayers)
+}
+
+func (d DrawableFFAReward) ResponseAt(i int) Response {
+	return DrawableFFAPlayers[i]
 }
 
 //Responses returns a slice of ints from [0, 3]
 func (m MinigameFFAReward) Responses() []Response {
-	return MinigameFFAPlayers
+	return responses(m)
+}
+
+func (m MinigameFFAReward) ResponseCount() int {
+	return len(MinigameFFAPlayers)
+}
+
+func (m MinigameFFAReward) ResponseAt(i int) Response {
+	return MinigameFFAPlayers[i]
 }
 
 func (m MinigameFFAReward) ControllingPlayer() int {
 	return CPU_PLAYER
 }
 
-//MinigameFFAReward gives out 10 coins to player r. If r == 4, then no one
+//MinigameFFAReward gives out the game's MinigameCoins to player r. If r == 4, then no one
 //gains coins. If m.IsCoinMinigame is true, then the game's next event is
 //set to the containing coin minigame. Otherwise, the game's turn ends.
 func (m MinigameFFAReward) Handle(r Response, g *Game) {
 	player := r.(int)
 	if player != 4 {
-		g.AwardCoins(player, 10, true)
+		g.AwardCoins(player, g.Economy().MinigameCoins, true)
 	}
 	if m.IsCoinMinigame {
 		g.NextEvent = m.Coin
@@ -111,6 +127,14 @@ func (m MinigameFFAMultiWinReward) Responses() []Response {
 	return NewRange(0, 15)
 }
 
+func (m MinigameFFAMultiWinReward) ResponseCount() int {
+	return 16
+}
+
+func (m MinigameFFAMultiWinReward) ResponseAt(i int) Response {
+	return Range{0, 15}.ResponseAt(i)
+}
+
 func (m MinigameFFAMultiWinReward) ControllingPlayer() int {
 	return CPU_PLAYER
 }
@@ -153,7 +177,15 @@ func (m MinigameFFA1Loser) Type() EventType {
 
 //Responses returns a slice of ints from [0, 4].
 func (m MinigameFFA1Loser) Responses() []Response {
-	return FFA1LoserPlayers
+	return responses(m)
+}
+
+func (m MinigameFFA1Loser) ResponseCount() int {
+	return len(FFA1LoserPlayers)
+}
+
+func (m MinigameFFA1Loser) ResponseAt(i int) Response {
+	return FFA1LoserPlayers[i]
 }
 
 func (m MinigameFFA1Loser) ControllingPlayer() int {
@@ -161,13 +193,13 @@ func (m MinigameFFA1Loser) ControllingPlayer() int {
 }
 
 //Handle gives coins to player based on r. If r == 4, then all players win
-//10 coins. Otherwise, player r gives 5 coins to every other player.
+//the game's MinigameCoins. Otherwise, player r gives 5 coins to every other player.
 func (m MinigameFFA1Loser) Handle(r Response, g *Game) {
 	player := r.(int)
 	defer g.EndGameTurn()
 	if player == 4 {
 		for i := 0; i < 4; i++ {
-			g.AwardCoins(i, 10, true)
+			g.AwardCoins(i, g.Economy().MinigameCoins, true)
 		}
 		return
 	}
@@ -197,6 +229,16 @@ func (m MinigameFFACoopResponse) String() string {
 	return ""
 }
 
+func (m MinigameFFACoopResponse) Key() string {
+	switch m {
+	case MinigameFFACoopWin:
+		return "MinigameFFACoopWin"
+	case MinigameFFACoopLoss:
+		return "MinigameFFACoopLoss"
+	}
+	return ""
+}
+
 //MinigameFFACoop handles Free-For-All cooperative minigame rewards.
 //Players either win 10 coins each or lose 5 coins each.
 type MinigameFFACoop struct{}
@@ -213,8 +255,18 @@ func (m MinigameFFACoop) ControllingPlayer() int {
 	return CPU_PLAYER
 }
 
+var ffaCoopResponses = []Response{MinigameFFACoopWin, MinigameFFACoopLoss}
+
 func (m MinigameFFACoop) Responses() []Response {
-	return []Response{MinigameFFACoopWin, MinigameFFACoopLoss}
+	return responses(m)
+}
+
+func (m MinigameFFACoop) ResponseCount() int {
+	return len(ffaCoopResponses)
+}
+
+func (m MinigameFFACoop) ResponseAt(i int) Response {
+	return ffaCoopResponses[i]
 }
 
 //Handle gives coins to players based on r. If r == true, then each player
@@ -349,6 +401,60 @@ func (m MinigameFFAGame) String() string {
 	return ""
 }
 
+func (m MinigameFFAGame) Key() string {
+	switch m {
+	case MinigameFFABurriedTreasure:
+		return "MinigameFFABurriedTreasure"
+	case MinigameFFATreasureDivers:
+		return "MinigameFFATreasureDivers"
+	case MinigameFFAHotBobomb:
+		return "MinigameFFAHotBobomb"
+	case MinigameFFAMusicalMushroom:
+		return "MinigameFFAMusicalMushroom"
+	case MinigameFFACrazyCutter:
+		return "MinigameFFACrazyCutter"
+	case MinigameFFAFaceLift:
+		return "MinigameFFAFaceLift"
+	case MinigameFFABalloonBurst:
+		return "MinigameFFABalloonBurst"
+	case MinigameFFACoinBlockBlitz:
+		return "MinigameFFACoinBlockBlitz"
+	case MinigameFFASkateboardScamper:
+		return "MinigameFFASkateboardScamper"
+	case MinigameFFABoxMountainMayhem:
+		return "MinigameFFABoxMountainMayhem"
+	case MinigameFFAPlatformPeril:
+		return "MinigameFFAPlatformPeril"
+	case MinigameFFAMushroomMixup:
+		return "MinigameFFAMushroomMixup"
+	case MinigameFFAGrabBag:
+		return "MinigameFFAGrabBag"
+	case MinigameFFABumperBalls:
+		return "MinigameFFABumperBalls"
+	case MinigameFFATipsyTourney:
+		return "MinigameFFATipsyTourney"
+	case MinigameFFABombsAway:
+		return "MinigameFFABombsAway"
+	case MinigameFFAMarioBandstand:
+		return "MinigameFFAMarioBandstand"
+	case MinigameFFAShyGuySays:
+		return "MinigameFFAShyGuySays"
+	case MinigameFFACastAways:
+		return "MinigameFFACastAways"
+	case MinigameFFAKeypaWay:
+		return "MinigameFFAKeypaWay"
+	case MinigameFFARunningoftheBulb:
+		return "MinigameFFARunningoftheBulb"
+	case MinigameFFAHotRopeJump:
+		return "MinigameFFAHotRopeJump"
+	case MinigameFFAHammerDrop:
+		return "MinigameFFAHammerDrop"
+	case MinigameFFASlotCarDerby:
+		return "MinigameFFASlotCarDerby"
+	}
+	return ""
+}
+
 //MinigameFFASelector selects which FFA minigame to play.
 type MinigameFFASelector struct{}
 
@@ -360,34 +466,44 @@ func (m MinigameFFASelector) Type() EventType {
 	return ENUM_EVT_TYPE
 }
 
+var ffaMinigames = []Response{
+	MinigameFFABurriedTreasure,
+	MinigameFFATreasureDivers,
+	MinigameFFAHotBobomb,
+	MinigameFFAMusicalMushroom,
+	MinigameFFACrazyCutter,
+	MinigameFFAFaceLift,
+	MinigameFFABalloonBurst,
+	MinigameFFACoinBlockBlitz,
+	MinigameFFASkateboardScamper,
+	MinigameFFABoxMountainMayhem,
+	MinigameFFAPlatformPeril,
+	MinigameFFAMushroomMixup,
+	MinigameFFAGrabBag,
+	MinigameFFABumperBalls,
+	MinigameFFATipsyTourney,
+	MinigameFFABombsAway,
+	MinigameFFAMarioBandstand,
+	MinigameFFAShyGuySays,
+	MinigameFFACastAways,
+	MinigameFFAKeypaWay,
+	MinigameFFARunningoftheBulb,
+	MinigameFFAHotRopeJump,
+	MinigameFFAHammerDrop,
+	MinigameFFASlotCarDerby,
+}
+
 //Responses returns a slice of all MinigameFFAGame enumerations.
 func (m MinigameFFASelector) Responses() []Response {
-	return []Response{
-		MinigameFFABurriedTreasure,
-		MinigameFFATreasureDivers,
-		MinigameFFAHotBobomb,
-		MinigameFFAMusicalMushroom,
-		MinigameFFACrazyCutter,
-		MinigameFFAFaceLift,
-		MinigameFFABalloonBurst,
-		MinigameFFACoinBlockBlitz,
-		MinigameFFASkateboardScamper,
-		MinigameFFABoxMountainMayhem,
-		MinigameFFAPlatformPeril,
-		MinigameFFAMushroomMixup,
-		MinigameFFAGrabBag,
-		MinigameFFABumperBalls,
-		MinigameFFATipsyTourney,
-		MinigameFFABombsAway,
-		MinigameFFAMarioBandstand,
-		MinigameFFAShyGuySays,
-		MinigameFFACastAways,
-		MinigameFFAKeypaWay,
-		MinigameFFARunningoftheBulb,
-		MinigameFFAHotRopeJump,
-		MinigameFFAHammerDrop,
-		MinigameFFASlotCarDerby,
-	}
+	return responses(m)
+}
+
+func (m MinigameFFASelector) ResponseCount() int {
+	return len(ffaMinigames)
+}
+
+func (m MinigameFFASelector) ResponseAt(i int) Response {
+	return ffaMinigames[i]
 }
 
 func (m MinigameFFASelector) ControllingPlayer() int {
@@ -397,6 +513,7 @@ func (m MinigameFFASelector) ControllingPlayer() int {
 //Handle sets the next event to the selected Minigame.
 func (m MinigameFFASelector) Handle(r Response, g *Game) {
 	game := r.(MinigameFFAGame)
+	g.startMinigame(game)
 	switch game {
 	case MinigameFFABurriedTreasure:
 		g.NextEvent = MinigameFFAReward{}
@@ -503,9 +620,29 @@ func (m Minigame2V2Result) String() string {
 	return ""
 }
 
+func (m Minigame2V2Result) Key() string {
+	switch m {
+	case Minigame2V2BlueWin:
+		return "Minigame2V2BlueWin"
+	case Minigame2V2RedWin:
+		return "Minigame2V2RedWin"
+	case Minigame2V2Draw:
+		return "Minigame2V2Draw"
+	}
+	return ""
+}
+
 //Responses returns a slice of ints from [0, 2]
 func (d DrawableMinigame2V2Reward) Responses() []Response {
-	return Drawable2V2Players
+	return append([]Response(nil), Drawable2V2Players...)
+}
+
+func (d DrawableMinigame2V2Reward) ResponseCount() int {
+	return len(Drawable2V2Players)
+}
+
+func (d DrawableMinigame2V2Reward) ResponseAt(i int) Response {
+	return Drawable2V2Players[i]
 }
 
 func (m Minigame2V2Reward) Type() EventType {
@@ -514,7 +651,15 @@ func (m Minigame2V2Reward) Type() EventType {
 
 //Responses returns a slice of ints from [0, 1]
 func (m Minigame2V2Reward) Responses() []Response {
-	return Minigame2V2Players
+	return responses(m)
+}
+
+func (m Minigame2V2Reward) ResponseCount() int {
+	return len(Minigame2V2Players)
+}
+
+func (m Minigame2V2Reward) ResponseAt(i int) Response {
+	return Minigame2V2Players[i]
 }
 
 func (m Minigame2V2Reward) ControllingPlayer() int {
@@ -526,16 +671,17 @@ func (m Minigame2V2Reward) ControllingPlayer() int {
 //takes coins from the blue team. Otherwise, it is considered a draw.
 func (m Minigame2V2Reward) Handle(r Response, g *Game) {
 	team := r.(Minigame2V2Result)
+	coins := g.Economy().MinigameCoins
 	if team == Minigame2V2BlueWin {
-		g.AwardCoins(m.BlueTeam[0], 10, true)
-		g.AwardCoins(m.BlueTeam[1], 10, true)
-		g.AwardCoins(m.RedTeam[0], -10, true)
-		g.AwardCoins(m.RedTeam[1], -10, true)
+		g.AwardCoins(m.BlueTeam[0], coins, true)
+		g.AwardCoins(m.BlueTeam[1], coins, true)
+		g.AwardCoins(m.RedTeam[0], -coins, true)
+		g.AwardCoins(m.RedTeam[1], -coins, true)
 	} else if team == Minigame2V2RedWin {
-		g.AwardCoins(m.RedTeam[0], 10, true)
-		g.AwardCoins(m.RedTeam[1], 10, true)
-		g.AwardCoins(m.BlueTeam[0], -10, true)
-		g.AwardCoins(m.BlueTeam[1], -10, true)
+		g.AwardCoins(m.RedTeam[0], coins, true)
+		g.AwardCoins(m.RedTeam[1], coins, true)
+		g.AwardCoins(m.BlueTeam[0], -coins, true)
+		g.AwardCoins(m.BlueTeam[1], -coins, true)
 	}
 	g.EndGameTurn()
 }
@@ -612,6 +758,22 @@ func (m Minigame2V2Game) String() string {
 	return ""
 }
 
+func (m Minigame2V2Game) Key() string {
+	switch m {
+	case Minigame2V2BobsledRun:
+		return "Minigame2V2BobsledRun"
+	case Minigame2V2DesertDash:
+		return "Minigame2V2DesertDash"
+	case Minigame2V2Bombsketball:
+		return "Minigame2V2Bombsketball"
+	case Minigame2V2HandcarHavoc:
+		return "Minigame2V2HandcarHavoc"
+	case Minigame2V2DeepSeaDivers:
+		return "Minigame2V2DeepSeaDivers"
+	}
+	return ""
+}
+
 //Minigame2V2Selector selects which 2V2 minigame to play.
 type Minigame2V2Selector struct {
 	Team1 [2]int
@@ -626,15 +788,25 @@ func (m Minigame2V2Selector) Type() EventType {
 	return ENUM_EVT_TYPE
 }
 
+var minigames2V2 = []Response{
+	Minigame2V2BobsledRun,
+	Minigame2V2DesertDash,
+	Minigame2V2Bombsketball,
+	Minigame2V2HandcarHavoc,
+	Minigame2V2DeepSeaDivers,
+}
+
 //Responses returns a slice of all Minigame2V2Game enumerations.
 func (m Minigame2V2Selector) Responses() []Response {
-	return []Response{
-		Minigame2V2BobsledRun,
-		Minigame2V2DesertDash,
-		Minigame2V2Bombsketball,
-		Minigame2V2HandcarHavoc,
-		Minigame2V2DeepSeaDivers,
-	}
+	return responses(m)
+}
+
+func (m Minigame2V2Selector) ResponseCount() int {
+	return len(minigames2V2)
+}
+
+func (m Minigame2V2Selector) ResponseAt(i int) Response {
+	return minigames2V2[i]
 }
 
 func (m Minigame2V2Selector) ControllingPlayer() int {
@@ -644,6 +816,7 @@ func (m Minigame2V2Selector) ControllingPlayer() int {
 //Handle sets the next event to the selected Minigame.
 func (m Minigame2V2Selector) Handle(r Response, g *Game) {
 	game := r.(Minigame2V2Game)
+	g.startMinigame(game)
 	switch game {
 	case Minigame2V2BobsledRun:
 		g.NextEvent = Minigame2V2Reward{
@@ -694,6 +867,18 @@ func (m Minigame1V3Result) String() string {
 	return ""
 }
 
+func (m Minigame1V3Result) Key() string {
+	switch m {
+	case Minigame1V3SingleWin:
+		return "Minigame1V3SingleWin"
+	case Minigame1V3TeamWin:
+		return "Minigame1V3TeamWin"
+	case Minigame1V3Draw:
+		return "Minigame1V3Draw"
+	}
+	return ""
+}
+
 //Drawable1V3Reward handles 1v3 minigame rewards. Zero or One team will
 //gain coins from this event, while the other team may lose coins.
 type Drawable1V3Reward Minigame1V3Reward
@@ -714,12 +899,28 @@ func (m Minigame1V3Reward) Type() EventType {
 
 //Responses returns a slice of ints from [0, 2]
 func (d Drawable1V3Reward) Responses() []Response {
-	return Drawable1V3Players
+	return append([]Response(nil), Drawable1V3Players...)
+}
+
+func (d Drawable1V3Reward) ResponseCount() int {
+	return len(Drawable1V3Players)
+}
+
+func (d Drawable1V3Reward) ResponseAt(i int) Response {
+	return Drawable1V3Players[i]
 }
 
 //Responses returns a slice of ints from [0, 1]
 func (m Minigame1V3Reward) Responses() []Response {
-	return Minigame1V3Players
+	return responses(m)
+}
+
+func (m Minigame1V3Reward) ResponseCount() int {
+	return len(Minigame1V3Players)
+}
+
+func (m Minigame1V3Reward) ResponseAt(i int) Response {
+	return Minigame1V3Players[i]
 }
 
 func (m Minigame1V3Reward) ControllingPlayer() int {
@@ -767,6 +968,16 @@ func (t Throwable1V3MinigameResponse) String() string {
 	return ""
 }
 
+func (t Throwable1V3MinigameResponse) Key() string {
+	switch t {
+	case Throwable1V3MinigameThrow:
+		return "Throwable1V3MinigameThrow"
+	case Throwable1V3MinigameNoThrow:
+		return "Throwable1V3MinigameNoThrow"
+	}
+	return ""
+}
+
 //Throwable1V3Minigame is a minigame that the Solo player may choose to
 //lose, causing no one to gain coins.
 type Throwable1V3Minigame struct {
@@ -789,11 +1000,21 @@ func (t Throwable1V3Minigame) ControllingPlayer() int {
 	return t.Player
 }
 
+var throwable1V3Responses = []Response{
+	Throwable1V3MinigameThrow,
+	Throwable1V3MinigameNoThrow,
+}
+
 func (t Throwable1V3Minigame) Responses() []Response {
-	return []Response{
-		Throwable1V3MinigameThrow,
-		Throwable1V3MinigameNoThrow,
-	}
+	return responses(t)
+}
+
+func (t Throwable1V3Minigame) ResponseCount() int {
+	return len(throwable1V3Responses)
+}
+
+func (t Throwable1V3Minigame) ResponseAt(i int) Response {
+	return throwable1V3Responses[i]
 }
 
 //Handle chooses whether to throw the minigame based on r. If r == true,
@@ -823,19 +1044,29 @@ func (m MinigamePipeMaze) Type() EventType {
 	return PLAYER_EVT_TYPE
 }
 
+var pipeMazeResponses = []Response{0, 1, 2, 3}
+
 //Responses returns a slice of ints from [0,3].
 func (m MinigamePipeMaze) Responses() []Response {
-	return []Response{0, 1, 2, 3}
+	return responses(m)
+}
+
+func (m MinigamePipeMaze) ResponseCount() int {
+	return len(pipeMazeResponses)
+}
+
+func (m MinigamePipeMaze) ResponseAt(i int) Response {
+	return pipeMazeResponses[i]
 }
 
 func (m MinigamePipeMaze) ControllingPlayer() int {
 	return m.Player
 }
 
-//Handle gives player r 10 coins.
+//Handle gives player r the game's MinigameCoins.
 func (m MinigamePipeMaze) Handle(r Response, g *Game) {
 	player := r.(int)
-	g.AwardCoins(player, 10, true)
+	g.AwardCoins(player, g.Economy().MinigameCoins, true)
 	g.EndGameTurn()
 }
 
@@ -952,32 +1183,23 @@ func (m MinigameBowlOver) Type() EventType {
 
 //Responses returns all valid Bowl Over minigame endings.
 func (m MinigameBowlOver) Responses() []Response {
-	return []Response{
-		MinigameBowlOverResponse{m.Player, 0, [3]bool{false, false, false}},
-		MinigameBowlOverResponse{m.Player, 1, [3]bool{false, false, false}},
-		MinigameBowlOverResponse{m.Player, 2, [3]bool{false, false, false}},
-		MinigameBowlOverResponse{m.Player, 0, [3]bool{false, false, true}},
-		MinigameBowlOverResponse{m.Player, 1, [3]bool{false, false, true}},
-		MinigameBowlOverResponse{m.Player, 2, [3]bool{false, false, true}},
-		MinigameBowlOverResponse{m.Player, 0, [3]bool{false, true, false}},
-		MinigameBowlOverResponse{m.Player, 1, [3]bool{false, true, false}},
-		MinigameBowlOverResponse{m.Player, 2, [3]bool{false, true, false}},
-		MinigameBowlOverResponse{m.Player, 0, [3]bool{false, true, true}},
-		MinigameBowlOverResponse{m.Player, 1, [3]bool{false, true, true}},
-		MinigameBowlOverResponse{m.Player, 2, [3]bool{false, true, true}},
-		MinigameBowlOverResponse{m.Player, 0, [3]bool{true, false, false}},
-		MinigameBowlOverResponse{m.Player, 1, [3]bool{true, false, false}},
-		MinigameBowlOverResponse{m.Player, 2, [3]bool{true, false, false}},
-		MinigameBowlOverResponse{m.Player, 0, [3]bool{true, false, true}},
-		MinigameBowlOverResponse{m.Player, 1, [3]bool{true, false, true}},
-		MinigameBowlOverResponse{m.Player, 2, [3]bool{true, false, true}},
-		MinigameBowlOverResponse{m.Player, 0, [3]bool{true, true, false}},
-		MinigameBowlOverResponse{m.Player, 1, [3]bool{true, true, false}},
-		MinigameBowlOverResponse{m.Player, 2, [3]bool{true, true, false}},
-		MinigameBowlOverResponse{m.Player, 0, [3]bool{true, true, true}},
-		MinigameBowlOverResponse{m.Player, 1, [3]bool{true, true, true}},
-		MinigameBowlOverResponse{m.Player, 2, [3]bool{true, true, true}},
+	return responses(m)
+}
+
+func (m MinigameBowlOver) ResponseCount() int {
+	return 24
+}
+
+//ResponseAt returns the ith result, counting up the regular pins first
+//and then the character pins as bits, from the last to the first.
+func (m MinigameBowlOver) ResponseAt(i int) Response {
+	if i < 0 || i >= 24 {
+		panic("mp1: MinigameBowlOver response index out of range")
 	}
+	chars := i / 3
+	return MinigameBowlOverResponse{m.Player, i % 3, [3]bool{
+		chars&4 != 0, chars&2 != 0, chars&1 != 0,
+	}}
 }
 
 func (m MinigameBowlOver) ControllingPlayer() int {
@@ -1017,9 +1239,19 @@ func (m MinigameCraneGameCoins) Type() EventType {
 	return ENUM_EVT_TYPE
 }
 
+var craneGameCoins = []Response{0, 1, 5, 10}
+
 //Responses returns a slice of ints: {0, 1, 5, 10}.
 func (m MinigameCraneGameCoins) Responses() []Response {
-	return []Response{0, 1, 5, 10}
+	return responses(m)
+}
+
+func (m MinigameCraneGameCoins) ResponseCount() int {
+	return len(craneGameCoins)
+}
+
+func (m MinigameCraneGameCoins) ResponseAt(i int) Response {
+	return craneGameCoins[i]
 }
 
 func (m MinigameCraneGameCoins) ControllingPlayer() int {
@@ -1072,6 +1304,17 @@ func (m MinigameCraneGamePlayers) Responses() []Response {
 	return []Response{m.Team[0], m.Team[1], m.Team[2], 4}
 }
 
+func (m MinigameCraneGamePlayers) ResponseCount() int {
+	return 4
+}
+
+func (m MinigameCraneGamePlayers) ResponseAt(i int) Response {
+	if i == 3 {
+		return 4
+	}
+	return m.Team[i]
+}
+
 func (m MinigameCraneGamePlayers) ControllingPlayer() int {
 	return CPU_PLAYER
 }
@@ -1161,6 +1404,32 @@ func (m Minigame1V3Game) String() string {
 	return ""
 }
 
+func (m Minigame1V3Game) Key() string {
+	switch m {
+	case Minigame1V3PipeMaze:
+		return "Minigame1V3PipeMaze"
+	case Minigame1V3BashnCash:
+		return "Minigame1V3BashnCash"
+	case Minigame1V3BowlOver:
+		return "Minigame1V3BowlOver"
+	case Minigame1V3CoinBlockBash:
+		return "Minigame1V3CoinBlockBash"
+	case Minigame1V3TightropeTreachery:
+		return "Minigame1V3TightropeTreachery"
+	case Minigame1V3CraneGame:
+		return "Minigame1V3CraneGame"
+	case Minigame1V3PiranhaPursuit:
+		return "Minigame1V3PiranhaPursuit"
+	case Minigame1V3TugoWar:
+		return "Minigame1V3TugoWar"
+	case Minigame1V3PaddleBattle:
+		return "Minigame1V3PaddleBattle"
+	case Minigame1V3CoinShowerFlower:
+		return "Minigame1V3CoinShowerFlower"
+	}
+	return ""
+}
+
 //Minigame1V3Selector selects which 1V3 minigame to play.
 type Minigame1V3Selector struct {
 	Player    int
@@ -1175,34 +1444,53 @@ func (m Minigame1V3Selector) Type() EventType {
 	return ENUM_EVT_TYPE
 }
 
+//minigames1V3 are the 1 vs 3 minigames.
+var minigames1V3 = []Response{
+	Minigame1V3PipeMaze,
+	Minigame1V3BashnCash,
+	Minigame1V3BowlOver,
+	Minigame1V3CoinBlockBash,
+	Minigame1V3TightropeTreachery,
+	Minigame1V3CraneGame,
+	Minigame1V3PiranhaPursuit,
+	Minigame1V3TugoWar,
+	Minigame1V3PaddleBattle,
+	Minigame1V3CoinShowerFlower,
+}
+
+//minigames1V3NoCoins are the 1 vs 3 minigames without BashnCash.
+var minigames1V3NoCoins = []Response{
+	Minigame1V3PipeMaze,
+	Minigame1V3BowlOver,
+	Minigame1V3CoinBlockBash,
+	Minigame1V3TightropeTreachery,
+	Minigame1V3CraneGame,
+	Minigame1V3PiranhaPursuit,
+	Minigame1V3TugoWar,
+	Minigame1V3PaddleBattle,
+	Minigame1V3CoinShowerFlower,
+}
+
 //Responses returns a slice of all Minigame1V3Game enumerations. If the
 //solo player has 0 coins, then BashnCash is not selected.
 func (m Minigame1V3Selector) Responses() []Response {
+	return responses(m)
+}
+
+//minigames returns the shared list of minigames that can be selected.
+func (m Minigame1V3Selector) minigames() []Response {
 	if m.SoloCoins == 0 {
-		return []Response{
-			Minigame1V3PipeMaze,
-			Minigame1V3BowlOver,
-			Minigame1V3CoinBlockBash,
-			Minigame1V3TightropeTreachery,
-			Minigame1V3CraneGame,
-			Minigame1V3PiranhaPursuit,
-			Minigame1V3TugoWar,
-			Minigame1V3PaddleBattle,
-			Minigame1V3CoinShowerFlower,
-		}
-	}
-	return []Response{
-		Minigame1V3PipeMaze,
-		Minigame1V3BashnCash,
-		Minigame1V3BowlOver,
-		Minigame1V3CoinBlockBash,
-		Minigame1V3TightropeTreachery,
-		Minigame1V3CraneGame,
-		Minigame1V3PiranhaPursuit,
-		Minigame1V3TugoWar,
-		Minigame1V3PaddleBattle,
-		Minigame1V3CoinShowerFlower,
+		return minigames1V3NoCoins
 	}
+	return minigames1V3
+}
+
+func (m Minigame1V3Selector) ResponseCount() int {
+	return len(m.minigames())
+}
+
+func (m Minigame1V3Selector) ResponseAt(i int) Response {
+	return m.minigames()[i]
 }
 
 func (m Minigame1V3Selector) ControllingPlayer() int {
@@ -1212,6 +1500,7 @@ func (m Minigame1V3Selector) ControllingPlayer() int {
 //Handle sets the next event to the selected Minigame.
 func (m Minigame1V3Selector) Handle(r Response, g *Game) {
 	minigame := r.(Minigame1V3Game)
+	g.startMinigame(minigame)
 	switch minigame {
 	case Minigame1V3PipeMaze:
 		g.NextEvent = MinigamePipeMaze{m.Player}
@@ -1255,9 +1544,19 @@ func (m Minigame1PRewards) Type() EventType {
 	return ENUM_EVT_TYPE
 }
 
+var minigame1PRewards = []Response{-5, 10}
+
 //Responses returns a slice of ints, {-5, 10}.
 func (m Minigame1PRewards) Responses() []Response {
-	return []Response{-5, 10}
+	return responses(m)
+}
+
+func (m Minigame1PRewards) ResponseCount() int {
+	return len(minigame1PRewards)
+}
+
+func (m Minigame1PRewards) ResponseAt(i int) Response {
+	return minigame1PRewards[i]
 }
 
 func (m Minigame1PRewards) ControllingPlayer() int {
@@ -1276,9 +1575,19 @@ type MinigameMemoryMatch struct {
 	Minigame1PRewards
 }
 
+var memoryMatchCoins = []Response{0, 2, 4, 6, 10}
+
 //Responses returns a slice of ints, {0, 2, 4, 6, 10}.
 func (m MinigameMemoryMatch) Responses() []Response {
-	return []Response{0, 2, 4, 6, 10}
+	return responses(m)
+}
+
+func (m MinigameMemoryMatch) ResponseCount() int {
+	return len(memoryMatchCoins)
+}
+
+func (m MinigameMemoryMatch) ResponseAt(i int) Response {
+	return memoryMatchCoins[i]
 }
 
 //MinigameSlotMachine hodls the implementation for Slot Machine.
@@ -1286,12 +1595,22 @@ type MinigameSlotMachine struct {
 	Minigame1PRewards
 }
 
+var slotMachineCoins = []Response{
+	0, 1, 3, 5,
+	6, 8, 10, 20,
+}
+
 //Responses returns a slice of ints, {0, 1, 3, 5, 6, 8, 10, 20}.
 func (m MinigameSlotMachine) Responses() []Response {
-	return []Response{
-		0, 1, 3, 5,
-		6, 8, 10, 20,
-	}
+	return responses(m)
+}
+
+func (m MinigameSlotMachine) ResponseCount() int {
+	return len(slotMachineCoins)
+}
+
+func (m MinigameSlotMachine) ResponseAt(i int) Response {
+	return slotMachineCoins[i]
 }
 
 //MinigameWhackaPlant holds the implementation for Whack a Plant.
@@ -1304,16 +1623,34 @@ func (m MinigameWhackaPlant) Responses() []Response {
 	return NewRange(0, 36)
 }
 
+func (m MinigameWhackaPlant) ResponseCount() int {
+	return 37
+}
+
+func (m MinigameWhackaPlant) ResponseAt(i int) Response {
+	return Range{0, 36}.ResponseAt(i)
+}
+
 //MinigameTeeteringTowers holds the implementation for Teetering Towers.
 type MinigameTeeteringTowers struct {
 	Minigame1PRewards
 }
 
+var teeteringTowersCoins = []Response{ //Mix of coin and coinbag
+	-5, 10, 11, 15, 16,
+}
+
 //Responses returns a slice of ints, {-5, 10, 11, 15, 16}.
 func (m MinigameTeeteringTowers) Responses() []Response {
-	return []Response{ //Mix of coin and coinbag
-		-5, 10, 11, 15, 16,
-	}
+	return responses(m)
+}
+
+func (m MinigameTeeteringTowers) ResponseCount() int {
+	return len(teeteringTowersCoins)
+}
+
+func (m MinigameTeeteringTowers) ResponseAt(i int) Response {
+	return teeteringTowersCoins[i]
 }
 
 //Minigame1PGame is a enumeration of the available 1P minigames.
@@ -1358,6 +1695,32 @@ func (m Minigame1PGame) String() string {
 	return ""
 }
 
+func (m Minigame1PGame) Key() string {
+	switch m {
+	case Minigame1PMemoryMatch:
+		return "Minigame1PMemoryMatch"
+	case Minigame1PSlotMachine:
+		return "Minigame1PSlotMachine"
+	case Minigame1PShellGame:
+		return "Minigame1PShellGame"
+	case Minigame1PGhostGuess:
+		return "Minigame1PGhostGuess"
+	case Minigame1PPedalPower:
+		return "Minigame1PPedalPower"
+	case Minigame1PWhackaPlant:
+		return "Minigame1PWhackaPlant"
+	case Minigame1PGroundPound:
+		return "Minigame1PGroundPound"
+	case Minigame1PTeeteringTowers:
+		return "Minigame1PTeeteringTowers"
+	case Minigame1PKnockBlockTower:
+		return "Minigame1PKnockBlockTower"
+	case Minigame1PLimboDance:
+		return "Minigame1PLimboDance"
+	}
+	return ""
+}
+
 //Minigame1PSelector selects which 1P minigame to play.
 type Minigame1PSelector struct {
 	Player int
@@ -1371,20 +1734,30 @@ func (m Minigame1PSelector) Type() EventType {
 	return ENUM_EVT_TYPE
 }
 
+var minigames1P = []Response{
+	Minigame1PMemoryMatch,
+	Minigame1PSlotMachine,
+	Minigame1PShellGame,
+	Minigame1PGhostGuess,
+	Minigame1PPedalPower,
+	Minigame1PWhackaPlant,
+	Minigame1PGroundPound,
+	Minigame1PTeeteringTowers,
+	Minigame1PKnockBlockTower,
+	Minigame1PLimboDance,
+}
+
 //Responses returns a slice of all Minigame1PGame enumerations.
 func (m Minigame1PSelector) Responses() []Response {
-	return []Response{
-		Minigame1PMemoryMatch,
-		Minigame1PSlotMachine,
-		Minigame1PShellGame,
-		Minigame1PGhostGuess,
-		Minigame1PPedalPower,
-		Minigame1PWhackaPlant,
-		Minigame1PGroundPound,
-		Minigame1PTeeteringTowers,
-		Minigame1PKnockBlockTower,
-		Minigame1PLimboDance,
-	}
+	return responses(m)
+}
+
+func (m Minigame1PSelector) ResponseCount() int {
+	return len(minigames1P)
+}
+
+func (m Minigame1PSelector) ResponseAt(i int) Response {
+	return minigames1P[i]
 }
 
 func (m Minigame1PSelector) ControllingPlayer() int {
@@ -1394,6 +1767,7 @@ func (m Minigame1PSelector) ControllingPlayer() int {
 //Handle sets the next event to the selected Minigame.
 func (m Minigame1PSelector) Handle(r Response, g *Game) {
 	game := r.(Minigame1PGame)
+	g.startMinigame(game)
 	baseGame := Minigame1PRewards{m.Player}
 	switch game {
 	case Minigame1PMemoryMatch:
@@ -1440,6 +1814,18 @@ func (m MinigameTeam) String() string {
 	return ""
 }
 
+func (m MinigameTeam) Key() string {
+	switch m {
+	case BlueTeam:
+		return "BlueTeam"
+	case RedTeam:
+		return "RedTeam"
+	case GreenTeam:
+		return "GreenTeam"
+	}
+	return ""
+}
+
 //SpaceToTeam is a mapping from SpaceType to MinigameTeam.
 func SpaceToTeam(s SpaceType) MinigameTeam {
 	switch s {
@@ -0,0 +1,78 @@
+package mp1
+
+import "fmt"
+
+//Phase is the part of a turn the game is in. It is kept by the engine's
+//turn functions, so tools don't have to guess it from NextEvent.
+type Phase int
+
+const (
+	//RollPhase is set by SetDiceBlock, while the current character picks
+	//and rolls a dice block. It is also the phase of a new game.
+	RollPhase Phase = iota
+
+	//MovePhase is set by MovePlayer, while a character moves and
+	//responds to the spaces they pass, such as branches, Boo and star
+	//spaces.
+	MovePhase
+
+	//LandPhase is set by ActivateSpace, while the space a character
+	//landed on is acted out.
+	LandPhase
+
+	//EndTurnPhase is set by EndCharacterTurn, while the board's end of
+	//turn events are played.
+	EndTurnPhase
+
+	//MinigamePhase is set by StartMinigamePrep, from picking the teams
+	//until the end of round minigame is rewarded.
+	MinigamePhase
+
+	//GameOverPhase is set by EndGameTurn once the last turn has ended.
+	GameOverPhase
+)
+
+var phaseNames = [...]string{
+	RollPhase:     "roll",
+	MovePhase:     "move",
+	LandPhase:     "land",
+	EndTurnPhase:  "end_turn",
+	MinigamePhase: "minigame",
+	GameOverPhase: "game_over",
+}
+
+func (p Phase) String() string {
+	if p < 0 || int(p) >= len(phaseNames) {
+		return fmt.Sprintf("Phase(%d)", int(p))
+	}
+	return phaseNames[p]
+}
+
+//MarshalText encodes p as its name.
+func (p Phase) MarshalText() ([]byte, error) {
+	if p < 0 || int(p) >= len(phaseNames) {
+		return nil, fmt.Errorf("mp1: unknown phase %d", int(p))
+	}
+	return []byte(phaseNames[p]), nil
+}
+
+//UnmarshalText decodes a phase encoded by MarshalText.
+func (p *Phase) UnmarshalText(text []byte) error {
+	for i, name := range phaseNames {
+		if name == string(text) {
+			*p = Phase(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("mp1: unknown phase %q", text)
+}
+
+//InMinigame returns true if the game is in the end of round minigame.
+func (g *Game) InMinigame() bool {
+	return g.Phase == MinigamePhase
+}
+
+//IsMoving returns true if the current character is moving.
+func (g *Game) IsMoving() bool {
+	return g.Phase == MovePhase
+}
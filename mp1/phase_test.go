@@ -0,0 +1,101 @@
+package mp1
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func PhaseIs(expected Phase, g Game, flavour string, t *testing.T) {
+	t.Helper()
+	if g.Phase != expected {
+		t.Errorf("%s: Expected phase %s, got: %s", flavour, expected, g.Phase)
+	}
+}
+
+func TestPhaseTurn(t *testing.T) {
+	g := InitializeGame(partyBoard, GameConfig{MaxTurns: 20})
+	PhaseIs(RollPhase, *g, "New Game", t)
+	g.HandleEvent(NewChainSpace(0, 7))
+
+	g.Players[0].CurrentSpace = NewChainSpace(0, 8)
+	g.HandleEvent(2)
+	EventIs(BranchEvent{0, 2, (*partyBoard.Links)[0]}, g.NextEvent,
+		"Branch", t)
+	PhaseIs(MovePhase, *g, "Branch", t)
+	if !g.IsMoving() || g.InMinigame() {
+		t.Errorf("Branch: Expected player to be moving")
+	}
+
+	g.HandleEvent(NewChainSpace(1, 0))
+	PhaseIs(RollPhase, *g, "Next Player", t)
+	if g.CurrentPlayer != 1 {
+		t.Fatalf("Expected player 1's turn, got: %d", g.CurrentPlayer)
+	}
+
+	g.HandleEvent(4)
+	EventIs(ChanceTime{Player: 1}, g.NextEvent, "Chance Time", t)
+	PhaseIs(LandPhase, *g, "Chance Time", t)
+	if g.IsMoving() {
+		t.Errorf("Chance Time: Expected player to have stopped")
+	}
+
+	g.NextEvent = nil
+	g.CurrentPlayer = 3
+	g.EndCharacterTurn()
+	PhaseIs(MinigamePhase, *g, "Minigame", t)
+	if !g.InMinigame() {
+		t.Errorf("Minigame: Expected game to be in minigame")
+	}
+
+	g.Turn = g.Config.MaxTurns - 1
+	g.EndGameTurn()
+	PhaseIs(GameOverPhase, *g, "Game Over", t)
+}
+
+func TestPhaseRandomGames(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 20; i++ {
+		g := InitializeGame(partyBoard, GameConfig{MaxTurns: 20})
+		for g.NextEvent != nil {
+			switch g.NextEvent.(type) {
+			case NormalDiceBlock, PickDiceBlock:
+				PhaseIs(RollPhase, *g, "Dice Block", t)
+			case ChanceTime:
+				PhaseIs(LandPhase, *g, "Landing", t)
+			}
+			n := ResponseCount(g.NextEvent)
+			g.HandleEvent(ResponseAt(g.NextEvent, r.Intn(n)))
+		}
+		PhaseIs(GameOverPhase, *g, "Game Over", t)
+	}
+}
+
+func TestPhaseText(t *testing.T) {
+	for p := RollPhase; p <= GameOverPhase; p++ {
+		text, err := p.MarshalText()
+		if err != nil {
+			t.Fatalf("Expected no error for %s, got: %v", p, err)
+		}
+		var got Phase
+		if err := got.UnmarshalText(text); err != nil || got != p {
+			t.Errorf("Expected %s, got: %s, %v", p, got, err)
+		}
+	}
+	if _, err := Phase(-1).MarshalText(); err == nil {
+		t.Errorf("Expected error marshalling unknown phase")
+	}
+	var p Phase
+	if err := p.UnmarshalText([]byte("roll ")); err == nil {
+		t.Errorf("Expected error unmarshalling unknown phase")
+	}
+	if s := Phase(9).String(); s != "Phase(9)" {
+		t.Errorf("Expected Phase(9), got: %s", s)
+	}
+}
+
+func TestPhaseSaveLoad(t *testing.T) {
+	g := InitializeGame(ChanceBoard, GameConfig{MaxTurns: 20})
+	g.MovePlayer(0, 1)
+	loaded := SaveAndLoad(g, t)
+	PhaseIs(LandPhase, *loaded, "Loaded", t)
+}
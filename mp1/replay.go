@@ -0,0 +1,192 @@
+package mp1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+//ReplayVersion is the version of the format written by WriteReplay.
+//Version 2 stopped reading economy amounts of 0 as the default amount,
+//and version 3 added the save version of the checksum.
+const ReplayVersion = 3
+
+//ReplayLog is the ordered list of responses given to a game since
+//InitializeGame, along with everything needed to play it again.
+type ReplayLog struct {
+	Board     string
+	Config    GameConfig
+	Chars     [4]string
+	Responses []Response
+
+	//Checksum is the checksum of the game after the last response. It is
+	//empty until Finish is called. Checksums are of the saved game, so
+	//ChecksumVersion is the SaveVersion it was computed with.
+	Checksum        string
+	ChecksumVersion int
+}
+
+//NewReplayLog starts a log for g, which must be freshly initialized on a
+//registered board.
+func NewReplayLog(g *Game) (*ReplayLog, error) {
+	name, ok := BoardName(g.Board)
+	if !ok {
+		return nil, fmt.Errorf("mp1: board is not registered")
+	}
+	l := &ReplayLog{Board: name, Config: g.Config}
+	for i, p := range g.Players {
+		l.Chars[i] = p.Char
+	}
+	return l, nil
+}
+
+//HandleEvent records r and handles g's next event with it.
+func (l *ReplayLog) HandleEvent(g *Game, r Response) {
+	l.Responses = append(l.Responses, r)
+	g.HandleEvent(r)
+}
+
+//Finish records the checksum of g, the game the log was recorded from.
+func (l *ReplayLog) Finish(g *Game) error {
+	sum, err := g.Checksum()
+	if err != nil {
+		return err
+	}
+	l.Checksum = sum
+	l.ChecksumVersion = SaveVersion
+	return nil
+}
+
+//Verify replays the log on its registered board and compares the final
+//state with the recorded checksum. The replayed game is returned. A
+//checksum computed with another SaveVersion can't be compared, and is an
+//error rather than a mismatch.
+func (l ReplayLog) Verify() (*Game, error) {
+	b, ok := LookupBoard(l.Board)
+	if !ok {
+		return nil, fmt.Errorf("mp1: unknown board %q", l.Board)
+	}
+	g, err := Replay(b, l.Config, l)
+	if err != nil {
+		return nil, err
+	}
+	if l.ChecksumVersion != SaveVersion {
+		return g, fmt.Errorf("mp1: replay checksum of save version %d "+
+			"can't be verified with save version %d",
+			l.ChecksumVersion, SaveVersion)
+	}
+	sum, err := g.Checksum()
+	if err != nil {
+		return nil, err
+	}
+	if sum != l.Checksum {
+		return g, fmt.Errorf("mp1: replay checksum %s does not match %s",
+			sum, l.Checksum)
+	}
+	return g, nil
+}
+
+//Replay initializes a game on b with config and handles every response in
+//log in order with Apply. It returns an error if a response is not valid
+//for the next event, or if the game ends before the log does.
+func Replay(b Board, config GameConfig, log ReplayLog) (*Game, error) {
+	g := InitializeGame(b, config)
+	for i, c := range log.Chars {
+		g.Players[i].Char = c
+	}
+	for i, r := range log.Responses {
+		if g.NextEvent == nil {
+			return g, fmt.Errorf("mp1: game ended before response %d", i)
+		}
+		if err := g.Apply(r); err != nil {
+			return g, fmt.Errorf("mp1: response %d: %w", i, err)
+		}
+	}
+	return g, nil
+}
+
+//Checksum returns a hex encoded SHA-256 of g's saved form. Games with the
+//same state have the same checksum, as long as they are saved with the
+//same SaveVersion.
+func (g *Game) Checksum() (string, error) {
+	data, err := json.Marshal(g)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+//WriteReplay writes l to w as JSON.
+func WriteReplay(w io.Writer, l ReplayLog) error {
+	return json.NewEncoder(w).Encode(l)
+}
+
+//ReadReplay reads a log written by WriteReplay from r.
+func ReadReplay(r io.Reader) (ReplayLog, error) {
+	var l ReplayLog
+	err := json.NewDecoder(r).Decode(&l)
+	return l, err
+}
+
+//savedReplay is the encoded form of a ReplayLog.
+type savedReplay struct {
+	Version         int
+	Board           string
+	Config          GameConfig
+	Chars           [4]string
+	Responses       []*typedValue
+	Checksum        string
+	ChecksumVersion int `json:",omitempty"`
+}
+
+//MarshalJSON encodes l, including the type of every response.
+func (l ReplayLog) MarshalJSON() ([]byte, error) {
+	s := savedReplay{
+		Version:         ReplayVersion,
+		Board:           l.Board,
+		Config:          l.Config,
+		Chars:           l.Chars,
+		Responses:       make([]*typedValue, len(l.Responses)),
+		Checksum:        l.Checksum,
+		ChecksumVersion: l.ChecksumVersion,
+	}
+	for i, r := range l.Responses {
+		tv, err := marshalTyped(r)
+		if err != nil {
+			return nil, err
+		}
+		s.Responses[i] = tv
+	}
+	return json.Marshal(s)
+}
+
+//UnmarshalJSON decodes a ReplayLog encoded by MarshalJSON.
+func (l *ReplayLog) UnmarshalJSON(data []byte) error {
+	var s savedReplay
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s.Version != ReplayVersion {
+		return fmt.Errorf("mp1: unsupported replay version %d", s.Version)
+	}
+	responses := make([]Response, len(s.Responses))
+	for i, tv := range s.Responses {
+		r, err := unmarshalTyped(tv)
+		if err != nil {
+			return err
+		}
+		responses[i] = r
+	}
+	*l = ReplayLog{
+		Board:           s.Board,
+		Config:          s.Config,
+		Chars:           s.Chars,
+		Responses:       responses,
+		Checksum:        s.Checksum,
+		ChecksumVersion: s.ChecksumVersion,
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package mp1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func recordMinigameGame(t *testing.T) (*Game, *ReplayLog) {
+	g := InitializeGame(MinigameBoard, GameConfig{MaxTurns: 1})
+	g.Players[0].Char = "Mario"
+	l, err := NewReplayLog(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	responses := []Response{
+		3, Minigame1PSlotMachine, 20, //Player 0
+		4, Minigame1PMemoryMatch, 6, //Player 1
+		1, Minigame1PGhostGuess, -5, //Player 2
+		2, Minigame1PShellGame, 10, //Player 3
+		MinigameFFAGrabBag, 10, -5, 3, //End of turn minigame
+	}
+	for _, r := range responses {
+		l.HandleEvent(g, r)
+	}
+	if err := l.Finish(g); err != nil {
+		t.Fatal(err)
+	}
+	return g, l
+}
+
+func TestReplayRoundTrip(t *testing.T) {
+	g, l := recordMinigameGame(t)
+	if g.NextEvent != nil {
+		t.Fatalf("Expected game to be over, got: %#v", g.NextEvent)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteReplay(&buf, *l); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadReplay(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*l, read) {
+		t.Fatalf("Expected log:\n%#v\ngot:\n%#v", *l, read)
+	}
+
+	replayed, err := read.Verify()
+	if err != nil {
+		t.Fatalf("Expected replay to verify, got: %v", err)
+	}
+	if !reflect.DeepEqual(g, replayed) {
+		t.Errorf("Expected game:\n%#v\ngot:\n%#v", g, replayed)
+	}
+}
+
+func TestReplayChecksumMismatch(t *testing.T) {
+	_, l := recordMinigameGame(t)
+	l.Responses[len(l.Responses)-1] = 2
+	if _, err := l.Verify(); err == nil ||
+		!strings.Contains(err.Error(), "checksum") {
+		t.Errorf("Expected checksum error, got: %v", err)
+	}
+}
+
+func TestReplayInvalidResponse(t *testing.T) {
+	_, l := recordMinigameGame(t)
+	l.Responses[0] = 11
+	if _, err := l.Verify(); err == nil ||
+		!errors.Is(err, ErrInvalidResponse) {
+		t.Errorf("Expected invalid response error, got: %v", err)
+	}
+}
+
+func TestReplayTooManyResponses(t *testing.T) {
+	_, l := recordMinigameGame(t)
+	l.Responses = append(l.Responses, 1)
+	if _, err := l.Verify(); err == nil ||
+		!strings.Contains(err.Error(), "ended") {
+		t.Errorf("Expected game ended error, got: %v", err)
+	}
+}
+
+func TestReplayOtherSaveVersion(t *testing.T) {
+	_, l := recordMinigameGame(t)
+	l.ChecksumVersion = SaveVersion - 1
+	if _, err := l.Verify(); err == nil ||
+		!strings.Contains(err.Error(), "save version") {
+		t.Errorf("Expected save version error, got: %v", err)
+	}
+}
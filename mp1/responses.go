@@ -0,0 +1,43 @@
+package mp1
+
+//IndexedEvent is an Event whose responses can be read one at a time,
+//without building the slice returned by Responses. Every event of mp1 and
+//its boards implements it, so drivers playing many games should prefer
+//ResponseCount and ResponseAt over Responses.
+type IndexedEvent interface {
+	Event
+
+	//ResponseCount returns the number of responses, len(Responses()).
+	ResponseCount() int
+
+	//ResponseAt returns the ith response, Responses()[i]. It panics if i
+	//is out of range.
+	ResponseAt(i int) Response
+}
+
+//ResponseCount returns the number of responses of e, without building
+//them if e is an IndexedEvent.
+func ResponseCount(e Event) int {
+	if ie, ok := e.(IndexedEvent); ok {
+		return ie.ResponseCount()
+	}
+	return len(e.Responses())
+}
+
+//ResponseAt returns the ith response of e, without building the others
+//if e is an IndexedEvent.
+func ResponseAt(e Event, i int) Response {
+	if ie, ok := e.(IndexedEvent); ok {
+		return ie.ResponseAt(i)
+	}
+	return e.Responses()[i]
+}
+
+//responses returns every response of e, in order.
+func responses(e IndexedEvent) []Response {
+	ret := make([]Response, e.ResponseCount())
+	for i := range ret {
+		ret[i] = e.ResponseAt(i)
+	}
+	return ret
+}
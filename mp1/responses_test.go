@@ -0,0 +1,171 @@
+package mp1
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+//partyBoard holds every space type with a built in action, two star
+//spaces and a branch.
+var partyBoard = Board{
+	Chains: &[]Chain{
+		{
+			{Type: Start},
+			{Type: Blue},
+			{Type: Red},
+			{Type: MinigameSpace},
+			{Type: Chance},
+			{Type: Boo},
+			{Type: Mushroom},
+			{Type: Star},
+			{Type: Bowser},
+		},
+		{
+			{Type: Blue},
+			{Type: Star},
+			{Type: Red},
+			{Type: BogusItem},
+			{Type: Blue},
+		},
+	},
+	Links: &map[int]*[]ChainSpace{
+		0: {NewChainSpace(0, 0), NewChainSpace(1, 0)},
+		1: {NewChainSpace(0, 0)},
+	},
+	BowserCoins: 5,
+}
+
+//IndexedResponsesAre checks that e is an IndexedEvent whose responses
+//match Responses.
+func IndexedResponsesAre(e Event, flavour string, t *testing.T) {
+	t.Helper()
+	ie, ok := e.(IndexedEvent)
+	if !ok {
+		t.Errorf("%s: %T is not an IndexedEvent", flavour, e)
+		return
+	}
+	res := e.Responses()
+	if ie.ResponseCount() != len(res) {
+		t.Errorf("%s: %T has %d responses, ResponseCount returned %d",
+			flavour, e, len(res), ie.ResponseCount())
+		return
+	}
+	for i, r := range res {
+		if got := ie.ResponseAt(i); got != r {
+			t.Errorf("%s: %T response %d is %#v, ResponseAt returned %#v",
+				flavour, e, i, r, got)
+		}
+	}
+}
+
+func TestRegisteredEventsIndexed(t *testing.T) {
+	indexed := reflect.TypeOf((*IndexedEvent)(nil)).Elem()
+	event := reflect.TypeOf((*Event)(nil)).Elem()
+	for name, rt := range typeRegistry {
+		if rt.Implements(event) && !rt.Implements(indexed) {
+			t.Errorf("Registered event %s is not an IndexedEvent", name)
+		}
+	}
+}
+
+func TestIndexedResponses(t *testing.T) {
+	starData := StarData{
+		StarSpaceCount:  4,
+		RelativeVisited: 0b0101,
+		IndexToPosition: &[]ChainSpace{
+			{0, 1}, {0, 2}, {0, 3}, {0, 4},
+		},
+	}
+	players := [4]Player{{Coins: 5}, {Stars: 1}, {Coins: 60, Stars: 2}, {}}
+	tests := []struct {
+		flavour string
+		e       Event
+	}{
+		{"Range", NormalDiceBlock{Range{1, 10}, 0}},
+		{"Empty Range", BooCoinsEvent{PayRangeEvent{Range{1, 0}, 0}, 1, 0}},
+		{"Negative Range", MinigamePaddleBattle{Range{-10, 10}, 0}},
+		{"ChanceTime", ChanceTime{Player: 0}},
+		{"ChanceTime Left", ChanceTime{
+			LeftSideHit: true, LeftSidePosition: 2,
+		}},
+		{"ChanceTime Right", ChanceTime{
+			RightSideHit: true, RightSidePosition: 0, MiddleHit: true,
+		}},
+		{"StarLocationEvent", StarLocationEvent{StarData: starData}},
+		{"BooEvent", BooEvent{2, players, 0, 60, 50}},
+		{"BooEvent Poor", BooEvent{0, players, 0, 5, 50}},
+		{"WarpDiceBlock", WarpDiceBlock{2}},
+		{"PickDiceBlock", PickDiceBlock{1, GameConfig{}}},
+		{"PickDiceBlock All", PickDiceBlock{1, GameConfig{
+			RedDice: true, BlueDice: true, WarpDice: true, EventsDice: true,
+		}}},
+		{"PickDiceBlock Some", PickDiceBlock{1, GameConfig{
+			BlueDice: true, EventsDice: true,
+		}}},
+		{"MinigameBowlOver", MinigameBowlOver{1}},
+		{"MinigameCraneGamePlayers", MinigameCraneGamePlayers{0, [3]int{1, 2, 3}}},
+		{"Minigame1V3Selector", Minigame1V3Selector{0, 0}},
+		{"MinigameWhackaPlant", MinigameWhackaPlant{}},
+	}
+	for _, tt := range tests {
+		IndexedResponsesAre(tt.e, tt.flavour, t)
+	}
+}
+
+func TestResponsesCopied(t *testing.T) {
+	events := []Event{
+		MushroomEvent{},
+		BowserEvent{},
+		MinigameFFAReward{},
+		MinigameFFASelector{},
+		Minigame1V3Selector{0, 5},
+	}
+	for _, e := range events {
+		expected := e.Responses()
+		res := e.Responses()
+		for i := range res {
+			res[i] = nil
+		}
+		if got := e.Responses(); !reflect.DeepEqual(expected, got) {
+			t.Errorf("%T: Expected responses %#v after modifying a copy, "+
+				"got: %#v", e, expected, got)
+		}
+	}
+}
+
+func TestIndexedResponsesGame(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for game := 0; game < 50; game++ {
+		g := InitializeGame(partyBoard, GameConfig{
+			MaxTurns:   20,
+			RedDice:    true,
+			BlueDice:   true,
+			WarpDice:   true,
+			EventsDice: true,
+		})
+		for g.NextEvent != nil {
+			IndexedResponsesAre(g.NextEvent, "Game", t)
+			if t.Failed() {
+				return
+			}
+			i := r.Intn(ResponseCount(g.NextEvent))
+			g.HandleEvent(ResponseAt(g.NextEvent, i))
+		}
+	}
+}
+
+func BenchmarkResponses(b *testing.B) {
+	e := ChanceTime{}
+	for n := 0; n < b.N; n++ {
+		res := e.Responses()
+		_ = res[n%len(res)]
+	}
+}
+
+func BenchmarkResponseAt(b *testing.B) {
+	e := ChanceTime{}
+	for n := 0; n < b.N; n++ {
+		_ = ResponseAt(e, n%ResponseCount(e))
+	}
+}
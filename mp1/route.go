@@ -0,0 +1,151 @@
+package mp1
+
+//Route is the shortest way for a player to move from one space to
+//another.
+type Route struct {
+	//Spaces are the spaces the player steps on or is sent to after the
+	//space they start on, in order, ending on the target.
+	Spaces []ChainSpace
+	//Moves are the moves the route uses up, so a roll of Moves ends on
+	//the target if it uses up a move.
+	Moves int
+	//Coins are the most coins an Exit on the route needs.
+	Coins int
+}
+
+//routeStep is a move from one position to the next, through any spaces
+//passed on the way.
+type routeStep struct {
+	to     ChainSpace
+	spaces []ChainSpace
+	moves  int
+	coins  int
+}
+
+//moveCost returns the moves used up by stepping on space, as in
+//MovePlayer.
+func moveCost(space Space) int {
+	switch space.Type {
+	case Start, Boo, BogusItem:
+		return 0
+	case Invisible:
+		if space.PassingEvent != nil {
+			return 0
+		}
+	}
+	return 1
+}
+
+//Distance returns the moves needed to get from one space to another, or
+//-1 if to can not be reached from from. Exits are taken whatever coins
+//they need.
+func (b Board) Distance(from, to ChainSpace) int {
+	route, ok := b.FindRoute(from, to, -1)
+	if !ok {
+		return -1
+	}
+	return route.Moves
+}
+
+//FindRoute returns the route from one space to another using up the
+//fewest moves, following chains and links as MovePlayer and CheckLinks
+//do. Start, Boo, BogusItem and Invisible spaces with a PassingEvent use
+//up no moves. Players passing a space with a PassingEvent leave through
+//its Exits, but Exits needing more than coins are not taken unless coins
+//is negative. Moves made by StoppingEvents are not followed. It returns
+//false if to can not be reached.
+func (b Board) FindRoute(from, to ChainSpace, coins int) (Route, bool) {
+	if !b.has(from) || !b.has(to) {
+		return Route{}, false
+	}
+	if from == to {
+		return Route{}, true
+	}
+	//Dijkstra's algorithm, in the order spaces are found so ties are
+	//broken by the order of links and Exits.
+	best := map[ChainSpace]Route{from: {}}
+	done := map[ChainSpace]bool{}
+	order := []ChainSpace{from}
+	for {
+		pos, found := ChainSpace{}, false
+		for _, p := range order {
+			if !done[p] && (!found || best[p].Moves < best[pos].Moves) {
+				pos, found = p, true
+			}
+		}
+		if !found {
+			return Route{}, false
+		}
+		if pos == to {
+			return best[pos], true
+		}
+		done[pos] = true
+		cur := best[pos]
+		for _, step := range b.routeSteps(pos, coins) {
+			prev, seen := best[step.to]
+			if seen && prev.Moves <= cur.Moves+step.moves {
+				continue
+			}
+			if !seen {
+				order = append(order, step.to)
+			}
+			spaces := append(cur.Spaces[:len(cur.Spaces):len(cur.Spaces)],
+				step.spaces...)
+			best[step.to] = Route{spaces, cur.Moves + step.moves,
+				max(cur.Coins, step.coins)}
+		}
+	}
+}
+
+//routeSteps returns every step a player on pos can take with their next
+//move, leaving spaces with a PassingEvent through their Exits.
+func (b Board) routeSteps(pos ChainSpace, coins int) []routeStep {
+	var steps []routeStep
+	passed := map[ChainSpace]bool{}
+	var enter func(pos ChainSpace, spaces []ChainSpace)
+	enter = func(pos ChainSpace, spaces []ChainSpace) {
+		space := (*b.Chains)[pos.Chain][pos.Space]
+		spaces = append(spaces[:len(spaces):len(spaces)], pos)
+		if space.PassingEvent == nil || len(space.Exits) == 0 {
+			steps = append(steps, routeStep{pos, spaces, moveCost(space), 0})
+			return
+		}
+		if passed[pos] {
+			return
+		}
+		passed[pos] = true
+		for _, exit := range space.Exits {
+			if coins >= 0 && exit.Coins > coins {
+				continue
+			}
+			if exit.Dest == pos { //Keeps walking
+				for _, n := range b.next(pos) {
+					enter(n, spaces)
+				}
+				continue
+			}
+			steps = append(steps, routeStep{
+				exit.Dest,
+				append(spaces[:len(spaces):len(spaces)], exit.Dest),
+				exit.Moves,
+				exit.Coins,
+			})
+		}
+	}
+	for _, n := range b.next(pos) {
+		enter(n, nil)
+	}
+	return steps
+}
+
+//StarRoute returns the route from player's space to the current star
+//space, avoiding Exits that need more coins than the player has. It
+//returns false if the star has not been placed or can not be reached.
+func (g *Game) StarRoute(player int) (Route, bool) {
+	star := g.StarSpaces.CurrentStarSpace
+	if g.StarSpaces.StarSpaceCount == 0 || g.StarSpaces.GetIndex(star) < 0 {
+		return Route{}, false
+	}
+	p := g.Players[player]
+	return g.Board.FindRoute(p.CurrentSpace, star, p.Coins)
+}
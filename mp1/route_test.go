@@ -0,0 +1,145 @@
+package mp1
+
+import (
+	"reflect"
+	"testing"
+)
+
+//TollBoard forks after a toll gate: paying 20 coins leads straight to the
+//star on chain 1, refusing leads the long way around chain 2.
+var TollBoard = Board{
+	Chains: &[]Chain{
+		{
+			{Type: Start},
+			{Type: Blue},
+			{
+				Type:         Invisible,
+				PassingEvent: func(g *Game, player, moves int) int { return moves },
+				Exits: []Exit{
+					{Dest: ChainSpace{1, 0}, Moves: 1, Coins: 20},
+					{Dest: ChainSpace{2, 0}, Moves: 1},
+				},
+			},
+		},
+		{{Type: Blue}, {Type: Star}},
+		{{Type: Red}, {Type: Boo}, {Type: Blue}, {Type: Star}},
+	},
+	Links: &map[int]*[]ChainSpace{
+		1: {{0, 0}},
+		2: {{0, 0}},
+	},
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		from, to ChainSpace
+		expected int
+	}{
+		{ChainSpace{0, 0}, ChainSpace{0, 1}, 1},
+		{ChainSpace{0, 1}, ChainSpace{1, 1}, 2},
+		//Boo uses up no moves
+		{ChainSpace{0, 1}, ChainSpace{2, 3}, 3},
+		//Start uses up no moves
+		{ChainSpace{1, 1}, ChainSpace{0, 1}, 1},
+		{ChainSpace{1, 1}, ChainSpace{0, 0}, 0},
+		{ChainSpace{2, 0}, ChainSpace{2, 0}, 0},
+	}
+	for _, test := range tests {
+		got := TollBoard.Distance(test.from, test.to)
+		if got != test.expected {
+			t.Errorf("Expected distance from %v to %v: %d, got: %d",
+				test.from, test.to, test.expected, got)
+		}
+	}
+	noLinks := Board{Chains: TollBoard.Chains}
+	if got := noLinks.Distance(ChainSpace{1, 0}, ChainSpace{0, 0}); got != -1 {
+		t.Errorf("Expected unreachable space, got: %d", got)
+	}
+	if got := TollBoard.Distance(ChainSpace{0, 0}, ChainSpace{5, 0}); got != -1 {
+		t.Errorf("Expected unknown space to be unreachable, got: %d", got)
+	}
+}
+
+func TestFindRouteToll(t *testing.T) {
+	route, ok := TollBoard.FindRoute(ChainSpace{0, 0}, ChainSpace{0, 1}, 0)
+	expected := Route{Spaces: []ChainSpace{{0, 1}}, Moves: 1}
+	if !ok || !reflect.DeepEqual(expected, route) {
+		t.Errorf("Expected route %+v, got: %+v", expected, route)
+	}
+
+	route, ok = TollBoard.FindRoute(ChainSpace{0, 0}, ChainSpace{1, 1}, 20)
+	expected = Route{
+		Spaces: []ChainSpace{{0, 1}, {0, 2}, {1, 0}, {1, 1}},
+		Moves:  3,
+		Coins:  20,
+	}
+	if !ok || !reflect.DeepEqual(expected, route) {
+		t.Errorf("Expected route %+v, got: %+v", expected, route)
+	}
+
+	//Without 20 coins the star on chain 1 is only reached by going
+	//around chain 2 first.
+	route, ok = TollBoard.FindRoute(ChainSpace{0, 0}, ChainSpace{1, 1}, 19)
+	if ok {
+		t.Errorf("Expected chain 1 to be unreachable, got: %+v", route)
+	}
+	route, ok = TollBoard.FindRoute(ChainSpace{0, 0}, ChainSpace{2, 3}, 19)
+	expected = Route{
+		Spaces: []ChainSpace{{0, 1}, {0, 2}, {2, 0}, {2, 1}, {2, 2}, {2, 3}},
+		Moves:  4,
+	}
+	if !ok || !reflect.DeepEqual(expected, route) {
+		t.Errorf("Expected route %+v, got: %+v", expected, route)
+	}
+}
+
+func TestFindRouteWarp(t *testing.T) {
+	warp := func(g *Game, player, moves int) int {
+		g.Players[player].CurrentSpace = ChainSpace{1, 0}
+		return moves
+	}
+	b := Board{
+		Chains: &[]Chain{
+			{
+				{Type: Start},
+				{Type: Invisible, PassingEvent: warp,
+					Exits: []Exit{{Dest: ChainSpace{1, 0}}}},
+				{Type: Star},
+			},
+			{{Type: Blue}, {Type: Star}},
+		},
+		Links: &map[int]*[]ChainSpace{0: {{0, 0}}, 1: {{0, 0}}},
+	}
+	route, ok := b.FindRoute(ChainSpace{0, 0}, ChainSpace{1, 1}, -1)
+	expected := Route{Spaces: []ChainSpace{{0, 1}, {1, 0}, {1, 1}}, Moves: 1}
+	if !ok || !reflect.DeepEqual(expected, route) {
+		t.Errorf("Expected route %+v, got: %+v", expected, route)
+	}
+	if d := b.Distance(ChainSpace{0, 0}, ChainSpace{0, 2}); d != -1 {
+		t.Errorf("Expected the warp to skip (0, 2), got distance: %d", d)
+	}
+
+	//Moves are counted as in MovePlayer
+	g := InitializeGame(b, GameConfig{MaxTurns: 20, NoKoopa: true})
+	g.MovePlayer(0, expected.Moves)
+	SpaceIs(ChainSpace{1, 1}, 0, *g, "", t)
+}
+
+func TestStarRoute(t *testing.T) {
+	g := InitializeGame(TollBoard, GameConfig{MaxTurns: 20})
+	if route, ok := g.StarRoute(0); ok {
+		t.Errorf("Expected no route before the star is placed, got: %+v", route)
+	}
+	g.NextEvent.Handle(ChainSpace{1, 1}, g)
+	route, ok := g.StarRoute(0)
+	if ok {
+		t.Errorf("Expected no route with 10 coins, got: %+v", route)
+	}
+	g.Players[0].Coins = 20
+	if route, ok = g.StarRoute(0); !ok || route.Moves != 3 {
+		t.Errorf("Expected a route of 3 moves, got: %+v", route)
+	}
+	if _, ok := InitializeGame(MakeSimpleBoard(Blue), GameConfig{}).StarRoute(0); ok {
+		t.Errorf("Expected no route on a board without stars")
+	}
+}
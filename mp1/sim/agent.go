@@ -0,0 +1,156 @@
+package sim
+
+import (
+	"math/rand"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//Agent decides how a seat, or the CPU, responds to events.
+type Agent interface {
+	//Choose returns one of e's responses. e is g.NextEvent.
+	Choose(g *mp1.Game, e mp1.Event) mp1.Response
+}
+
+//RandomAgent picks every response uniformly.
+type RandomAgent struct {
+	Rand *rand.Rand
+}
+
+//Choose returns a uniformly random response of e.
+func (a RandomAgent) Choose(g *mp1.Game, e mp1.Event) mp1.Response {
+	return mp1.ResponseAt(e, a.Rand.Intn(mp1.ResponseCount(e)))
+}
+
+//ModelAgent samples responses from a ChanceModel. It is the usual agent
+//for CPU_PLAYER events.
+type ModelAgent struct {
+	Model ChanceModel
+	Rand  *rand.Rand
+}
+
+//Choose returns a response of e sampled from the agent's model.
+func (a ModelAgent) Choose(g *mp1.Game, e mp1.Event) mp1.Response {
+	return Sample(a.Model, g, e, a.Rand)
+}
+
+//GreedyCoins picks the response that leaves the controlling player with
+//the most coins right after it is handled. Ties are broken at random.
+type GreedyCoins struct {
+	Rand *rand.Rand
+}
+
+//Choose returns the response of e with the best immediate coin result.
+func (a GreedyCoins) Choose(g *mp1.Game, e mp1.Event) mp1.Response {
+	return bestResponse(g, e, a.Rand, func(g *mp1.Game, player int) score {
+		return score{g.Players[player].Coins}
+	})
+}
+
+//StarChaser picks the response that gains the controlling player the most
+//stars, then the one that leaves them closest to
+//StarSpaces.CurrentStarSpace, then the one with the most coins. Ties are
+//broken at random.
+type StarChaser struct {
+	Rand *rand.Rand
+}
+
+//Choose returns the response of e that brings the player closest to a
+//star.
+func (a StarChaser) Choose(g *mp1.Game, e mp1.Event) mp1.Response {
+	return bestResponse(g, e, a.Rand, func(g *mp1.Game, player int) score {
+		dist := g.Board.Distance(g.Players[player].CurrentSpace,
+			g.StarSpaces.CurrentStarSpace)
+		if dist < 0 {
+			dist = unreachable
+		}
+		return score{
+			g.Players[player].Stars,
+			-dist,
+			g.Players[player].Coins,
+		}
+	})
+}
+
+//BooStarStealer steals a star with Boo whenever it can, from the player
+//with the most stars, and otherwise steals coins from the player with
+//the most coins. Every other event is left to Fallback.
+type BooStarStealer struct {
+	Fallback Agent
+}
+
+//Choose returns Boo's best steal for BooEvents and asks Fallback
+//otherwise.
+func (a BooStarStealer) Choose(g *mp1.Game, e mp1.Event) mp1.Response {
+	if _, ok := e.(mp1.BooEvent); !ok {
+		return a.Fallback.Choose(g, e)
+	}
+	var best mp1.BooStealAction
+	found := false
+	for _, r := range e.Responses() {
+		steal := r.(mp1.BooStealAction)
+		if !found || booBetter(g, steal, best) {
+			best = steal
+			found = true
+		}
+	}
+	return best
+}
+
+//booBetter returns true if steal a is better than steal b.
+func booBetter(g *mp1.Game, a, b mp1.BooStealAction) bool {
+	if a.Star != b.Star {
+		return a.Star
+	}
+	giverA := g.Players[a.GivingPlayer]
+	giverB := g.Players[b.GivingPlayer]
+	if a.Star {
+		return giverA.Stars > giverB.Stars
+	}
+	return giverA.Coins > giverB.Coins
+}
+
+//unreachable is the distance used for spaces that cannot be reached.
+const unreachable = 1000
+
+//score is compared lexicographically, higher is better.
+type score []int
+
+func (s score) less(o score) bool {
+	for i := range s {
+		if s[i] != o[i] {
+			return s[i] < o[i]
+		}
+	}
+	return false
+}
+
+//bestResponse handles every response of e on a clone of g and returns the
+//one with the best eval for the controlling player. Ties are broken with
+//r, or by the first response if r is nil.
+func bestResponse(g *mp1.Game, e mp1.Event, r *rand.Rand,
+	eval func(g *mp1.Game, player int) score) mp1.Response {
+	player := e.ControllingPlayer()
+	res := e.Responses()
+	if player == mp1.CPU_PLAYER || len(res) == 1 {
+		return res[0]
+	}
+	var best []mp1.Response
+	var bestScore score
+	for _, resp := range res {
+		c := g.Clone()
+		c.HandleEvent(resp)
+		s := eval(c, player)
+		switch {
+		case best == nil || bestScore.less(s):
+			best = []mp1.Response{resp}
+			bestScore = s
+		case !s.less(bestScore):
+			best = append(best, resp)
+		}
+	}
+	if r == nil {
+		return best[0]
+	}
+	return best[r.Intn(len(best))]
+}
@@ -0,0 +1,79 @@
+package sim
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//BranchBoard branches at the end of chain 0. Chain 1 starts on a red
+//space but leads to the star, chain 2 starts on a blue space and takes
+//the long way around.
+var BranchBoard = mp1.Board{
+	Chains: &[]mp1.Chain{
+		{{Type: mp1.Start}, {Type: mp1.Blue}},
+		{{Type: mp1.Red}, {Type: mp1.Blue}, {Type: mp1.Star}},
+		{
+			{Type: mp1.Blue}, {Type: mp1.Blue}, {Type: mp1.Blue},
+			{Type: mp1.Blue}, {Type: mp1.Blue},
+		},
+	},
+	Links: &map[int]*[]mp1.ChainSpace{
+		0: {{Chain: 1, Space: 0}, {Chain: 2, Space: 0}},
+		1: {{Chain: 0, Space: 0}},
+		2: {{Chain: 0, Space: 0}},
+	},
+}
+
+func branchGame() *mp1.Game {
+	g := mp1.InitializeGame(BranchBoard, mp1.GameConfig{MaxTurns: 20})
+	g.Players[0].CurrentSpace = mp1.ChainSpace{Chain: 0, Space: 1}
+	g.NextEvent = mp1.BranchEvent{
+		Player: 0,
+		Moves:  1,
+		Links:  (*BranchBoard.Links)[0],
+	}
+	return g
+}
+
+func TestGreedyCoins(t *testing.T) {
+	g := branchGame()
+	got := GreedyCoins{}.Choose(g, g.NextEvent)
+	expected := mp1.ChainSpace{Chain: 2, Space: 0}
+	if got != expected {
+		t.Errorf("Expected %v, got: %v", expected, got)
+	}
+	if g.Players[0].CurrentSpace != (mp1.ChainSpace{Chain: 0, Space: 1}) {
+		t.Errorf("Expected game to be untouched")
+	}
+}
+
+func TestStarChaser(t *testing.T) {
+	g := branchGame()
+	got := StarChaser{}.Choose(g, g.NextEvent)
+	expected := mp1.ChainSpace{Chain: 1, Space: 0}
+	if got != expected {
+		t.Errorf("Expected %v, got: %v", expected, got)
+	}
+}
+
+func TestBooStarStealer(t *testing.T) {
+	g := mp1.InitializeGame(BranchBoard, mp1.GameConfig{MaxTurns: 20})
+	g.Players[1].Stars = 2
+	g.Players[2].Stars = 3
+	g.Players[3].Coins = 40
+	a := BooStarStealer{RandomAgent{rand.New(rand.NewSource(0))}}
+
+	e := mp1.BooEvent{Player: 0, Players: g.Players, Coins: 60, StarPrice: 50}
+	expected := mp1.BooStealAction{RecvPlayer: 0, GivingPlayer: 2, Star: true}
+	if got := a.Choose(g, e); got != expected {
+		t.Errorf("Expected %v, got: %v", expected, got)
+	}
+
+	e.Coins = 10
+	expected = mp1.BooStealAction{RecvPlayer: 0, GivingPlayer: 3, Star: false}
+	if got := a.Choose(g, e); got != expected {
+		t.Errorf("Expected %v, got: %v", expected, got)
+	}
+}
@@ -0,0 +1,66 @@
+package sim
+
+import (
+	"context"
+	"math"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//DefaultRollouts is the number of rollouts EstimateWins plays when
+//Options.Games is 0.
+const DefaultRollouts = 1000
+
+//z95 is the z-score of a 95% confidence interval.
+const z95 = 1.959964
+
+//WinEstimate is the estimated probability of a player being in
+//Game.Winners() at the end of the game.
+type WinEstimate struct {
+	P float64
+	//Low and High bound the 95% Wilson score interval of P.
+	Low, High float64
+}
+
+//WinEstimate returns the estimated win probability of player. Every
+//player in a tie counts as a winner, as in Game.Winners(), so the
+//estimates of all players can add up to more than 1.
+func (r *Result) WinEstimate(player int) WinEstimate {
+	n := float64(r.Games)
+	if n == 0 {
+		return WinEstimate{0, 0, 1}
+	}
+	p := float64(r.Wins[player]) / n
+	z2 := z95 * z95
+	denom := 1 + z2/n
+	center := (p + z2/(2*n)) / denom
+	half := z95 * math.Sqrt(p*(1-p)/n+z2/(4*n*n)) / denom
+	return WinEstimate{
+		P:    p,
+		Low:  math.Max(0, center-half),
+		High: math.Min(1, center+half),
+	}
+}
+
+//EstimateWins estimates each player's probability of winning g by
+//playing opts.Games rollouts from g's exact state, whatever its next
+//event is. opts.Start, Board and Config are replaced by g; the rest of
+//opts is used as in Simulate. If opts.Games is 0, DefaultRollouts are
+//played. g is left untouched.
+func EstimateWins(ctx context.Context, g *mp1.Game, opts Options) ([4]WinEstimate, error) {
+	var ret [4]WinEstimate
+	opts.Start = g
+	opts.Board = g.Board
+	opts.Config = g.Config
+	if opts.Games == 0 {
+		opts.Games = DefaultRollouts
+	}
+	res, err := Simulate(ctx, opts)
+	if err != nil {
+		return ret, err
+	}
+	for i := range ret {
+		ret[i] = res.WinEstimate(i)
+	}
+	return ret, nil
+}
@@ -0,0 +1,78 @@
+package sim
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	"github.com/0xhexnumbers/partysim/mp1/board"
+)
+
+func TestWinEstimateInterval(t *testing.T) {
+	res := &Result{Games: 100, Wins: [4]int{50, 0, 100, 25}}
+	tests := []struct {
+		player    int
+		p, lo, hi float64
+	}{
+		{0, 0.5, 0.4038, 0.5962},
+		{1, 0, 0, 0.0370},
+		{2, 1, 0.9630, 1},
+		{3, 0.25, 0.1755, 0.3430},
+	}
+	for _, test := range tests {
+		got := res.WinEstimate(test.player)
+		if got.P != test.p || math.Abs(got.Low-test.lo) > 1e-4 ||
+			math.Abs(got.High-test.hi) > 1e-4 {
+			t.Errorf("Expected player %d estimate %v [%v, %v], got: %#v",
+				test.player, test.p, test.lo, test.hi, got)
+		}
+	}
+}
+
+func TestEstimateWinsDecided(t *testing.T) {
+	g := lastTurnBranchGame()
+	g.HandleEvent(mp1.ChainSpace{Chain: 1, Space: 0})
+	est, err := EstimateWins(context.Background(), g, Options{Games: 50})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if est[0].P != 1 || est[1].P != 0 {
+		t.Errorf("Expected player 1 to win, got: %#v", est)
+	}
+	if est[0].Low >= 1 || math.Abs(est[0].High-1) > 1e-9 {
+		t.Errorf("Expected interval below 1, got: %#v", est[0])
+	}
+}
+
+func TestEstimateWinsMidGame(t *testing.T) {
+	g := mp1.InitializeGame(board.WBC, testConfig)
+	r := rand.New(rand.NewSource(0))
+	runner := Runner{Chance: ModelAgent{HiddenBlockModel{}, r}}
+	for i := range runner.Players {
+		runner.Players[i] = GreedyCoins{r}
+	}
+	for g.NextEvent != nil && g.Turn < 10 {
+		runner.Step(g)
+	}
+	before := g.Clone()
+	est, err := EstimateWins(context.Background(), g, Options{Games: 200})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	total := 0.0
+	for i, e := range est {
+		if e.Low > e.P || e.P > e.High {
+			t.Errorf("Expected player %d P inside interval, got: %#v", i, e)
+		}
+		total += e.P
+	}
+	if total < 1 {
+		t.Errorf("Expected win probabilities to add up to at least 1, got: %v",
+			total)
+	}
+	if g.Turn != before.Turn || g.Players != before.Players {
+		t.Errorf("Expected game to be untouched")
+	}
+}
@@ -0,0 +1,193 @@
+package sim
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"reflect"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//ChanceModel assigns probabilities to the responses of events, usually
+//events controlled by mp1.CPU_PLAYER.
+type ChanceModel interface {
+	//Weights returns a weight for each response in res, which are the
+	//responses of e. Weights are relative and do not need to sum to 1.
+	Weights(g *mp1.Game, e mp1.Event, res []mp1.Response) []float64
+}
+
+//UniformModel gives every response the same weight. It is the model used
+//when no model is given: this package includes no weights measured from
+//Mario Party 1, so any other weights are up to the caller.
+type UniformModel struct{}
+
+//Weights returns a weight of 1 for every response.
+func (UniformModel) Weights(g *mp1.Game, e mp1.Event, res []mp1.Response) []float64 {
+	weights := make([]float64, len(res))
+	for i := range weights {
+		weights[i] = 1
+	}
+	return weights
+}
+
+//HiddenBlockModel is UniformModel, including for every dice block,
+//except for HiddenBlockEvent. Its weights are not measured from the
+//game: they assume a board holds a single hidden block, placed uniformly
+//on one of its blue or hidden block spaces. The event is asked on every
+//landing on such a space, so the block appears with probability 1/N,
+//where N is the number of those spaces on the board.
+type HiddenBlockModel struct{}
+
+//Weights returns uniform weights, and the assumed hidden block weights
+//for HiddenBlockEvent.
+func (HiddenBlockModel) Weights(g *mp1.Game, e mp1.Event, res []mp1.Response) []float64 {
+	weights := UniformModel{}.Weights(g, e, res)
+	if _, ok := e.(mp1.HiddenBlockEvent); ok {
+		n := hiddenBlockSpaces(g)
+		for i, r := range res {
+			if r == mp1.HiddenBlockNotThere {
+				weights[i] = float64(n - 1)
+			}
+		}
+	}
+	return weights
+}
+
+//hiddenBlockSpaces returns the number of spaces a hidden block can be
+//placed on, which is at least 1.
+func hiddenBlockSpaces(g *mp1.Game) int {
+	count := 0
+	for _, chain := range *g.Board.Chains {
+		for _, space := range chain {
+			if space.Type == mp1.Blue || space.HiddenBlock {
+				count++
+			}
+		}
+	}
+	if count == 0 {
+		return 1
+	}
+	return count
+}
+
+//OverrideModel replaces the weights of some events of a Base model.
+type OverrideModel struct {
+	Base ChanceModel
+
+	//Overrides maps an event name to the weights of its responses. Events
+	//are named by their mp1.RegisteredName, or by their Go type name if
+	//unregistered. Responses are named as printed by fmt.Sprint, which
+	//uses their String method. Responses of an overridden event that are
+	//not listed get a weight of 1.
+	Overrides map[string]map[string]float64
+}
+
+//LoadOverrides reads an OverrideModel on top of base from JSON of the
+//form {"HiddenBlockEvent": {"A hidden block appears": 1, ...}, ...}.
+func LoadOverrides(r io.Reader, base ChanceModel) (*OverrideModel, error) {
+	m := &OverrideModel{Base: base}
+	if err := json.NewDecoder(r).Decode(&m.Overrides); err != nil {
+		return nil, fmt.Errorf("sim: reading overrides: %v", err)
+	}
+	for evt, weights := range m.Overrides {
+		for res, w := range weights {
+			if w < 0 {
+				return nil, fmt.Errorf("sim: negative weight for %s %q",
+					evt, res)
+			}
+		}
+	}
+	return m, nil
+}
+
+//Weights returns the overridden weights of e if it has any, and the
+//weights of the base model otherwise.
+func (m *OverrideModel) Weights(g *mp1.Game, e mp1.Event, res []mp1.Response) []float64 {
+	overrides, ok := m.Overrides[eventName(e)]
+	if !ok {
+		return m.Base.Weights(g, e, res)
+	}
+	weights := make([]float64, len(res))
+	for i, r := range res {
+		if w, ok := overrides[fmt.Sprint(r)]; ok {
+			weights[i] = w
+		} else {
+			weights[i] = 1
+		}
+	}
+	return weights
+}
+
+//eventName returns the name e is overridden by.
+func eventName(e mp1.Event) string {
+	if name, ok := mp1.RegisteredName(e); ok {
+		return name
+	}
+	return reflect.TypeOf(e).Name()
+}
+
+//Sample picks a response of e at random, weighted by m. If every weight
+//is 0, responses are picked uniformly.
+func Sample(m ChanceModel, g *mp1.Game, e mp1.Event, r *rand.Rand) mp1.Response {
+	return mp1.ResponseAt(e, pick(m, g, e, r))
+}
+
+//pick returns the index of the response of e picked by Sample. Events
+//weighted uniformly are picked without building their responses.
+func pick(m ChanceModel, g *mp1.Game, e mp1.Event, r *rand.Rand) int {
+	if uniform(m, e) {
+		if n := mp1.ResponseCount(e); n > 0 {
+			//Same as sampleIndex with n weights of 1
+			i := int(r.Float64() * float64(n))
+			if i >= n {
+				i = n - 1
+			}
+			return i
+		}
+	}
+	return sampleIndex(m, g, e, e.Responses(), r)
+}
+
+//uniform returns true if m weighs every response of e the same.
+func uniform(m ChanceModel, e mp1.Event) bool {
+	switch m := m.(type) {
+	case UniformModel:
+		return true
+	case HiddenBlockModel:
+		_, hidden := e.(mp1.HiddenBlockEvent)
+		return !hidden
+	case *OverrideModel:
+		if _, ok := m.Overrides[eventName(e)]; !ok {
+			return uniform(m.Base, e)
+		}
+	}
+	return false
+}
+
+//sampleIndex returns the index of the response in res picked by Sample.
+func sampleIndex(m ChanceModel, g *mp1.Game, e mp1.Event, res []mp1.Response, r *rand.Rand) int {
+	weights := m.Weights(g, e, res)
+	total := 0.0
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return r.Intn(len(res))
+	}
+	x := r.Float64() * total
+	for i, w := range weights {
+		x -= w
+		if x < 0 {
+			return i
+		}
+	}
+	//Rounding errors, pick the last response with any weight
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return i
+		}
+	}
+	return len(res) - 1
+}
@@ -0,0 +1,87 @@
+package sim
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	"github.com/0xhexnumbers/partysim/mp1/board"
+)
+
+func TestHiddenBlockModel(t *testing.T) {
+	g := mp1.InitializeGame(board.MRC, testConfig)
+	e := mp1.HiddenBlockEvent{Player: 0}
+	res := e.Responses()
+	weights := HiddenBlockModel{}.Weights(g, e, res)
+	n := float64(hiddenBlockSpaces(g))
+	if n < 2 {
+		t.Fatalf("Expected several hidden block spaces, got: %v", n)
+	}
+	p := weights[0] / (weights[0] + weights[1])
+	if res[0] != mp1.HiddenBlockAppears || p != 1/n {
+		t.Errorf("Expected hidden block probability %v, got: %v", 1/n, p)
+	}
+}
+
+func TestOverrideModel(t *testing.T) {
+	m, err := LoadOverrides(strings.NewReader(
+		`{"NormalDiceBlock": {"1": 0, "2": 0, "3": 0, "4": 0, "5": 0,
+		"6": 0, "7": 0, "8": 0, "9": 0}}`,
+	), HiddenBlockModel{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	g := mp1.InitializeGame(board.MRC, testConfig)
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 20; i++ {
+		if got := Sample(m, g, g.NextEvent, r); got != 10 {
+			t.Fatalf("Expected 10, got: %v", got)
+		}
+	}
+	e := mp1.HiddenBlockEvent{Player: 0}
+	weights := m.Weights(g, e, e.Responses())
+	if weights[0] != 1 || weights[1] != float64(hiddenBlockSpaces(g)-1) {
+		t.Errorf("Expected base weights, got: %v", weights)
+	}
+}
+
+func TestLoadOverridesNegative(t *testing.T) {
+	_, err := LoadOverrides(strings.NewReader(
+		`{"BowserEvent": {"Coins For Bowser": -1}}`,
+	), HiddenBlockModel{})
+	if err == nil {
+		t.Errorf("Expected negative weight error")
+	}
+}
+
+func TestSampleAllZero(t *testing.T) {
+	m := &OverrideModel{
+		Base:      UniformModel{},
+		Overrides: map[string]map[string]float64{"HiddenBlockEvent": {"A hidden block appears": 0, "There is no hidden block": 0}},
+	}
+	g := mp1.InitializeGame(board.MRC, testConfig)
+	r := rand.New(rand.NewSource(0))
+	seen := map[mp1.Response]bool{}
+	for i := 0; i < 50; i++ {
+		seen[Sample(m, g, mp1.HiddenBlockEvent{Player: 0}, r)] = true
+	}
+	if len(seen) != 2 {
+		t.Errorf("Expected uniform fallback, got: %v", seen)
+	}
+}
+
+func TestSimulateWithModel(t *testing.T) {
+	m := &OverrideModel{
+		Base: HiddenBlockModel{},
+		Overrides: map[string]map[string]float64{
+			"HiddenBlockEvent": {"There is no hidden block": 0},
+		},
+	}
+	opts := Options{Board: board.MRC, Config: testConfig, Games: 5, Model: m}
+	res, err := Simulate(context.Background(), opts)
+	if err != nil || res.Games != 5 {
+		t.Errorf("Expected 5 games, got: %d, %v", res.Games, err)
+	}
+}
@@ -0,0 +1,244 @@
+package sim
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//ErrChanceEvent is returned by Planner.Plan when the next event is
+//controlled by mp1.CPU_PLAYER, so there is no decision to plan.
+var ErrChanceEvent = errors.New("sim: next event is a chance event")
+
+//Planner recommends responses to player decisions using Monte Carlo tree
+//search. Events controlled by mp1.CPU_PLAYER are chance nodes sampled
+//from Model, or UniformModel if it is nil. Every seat picks the moves
+//that maximize its own chance of winning, so other seats play as
+//opponents. Each iteration ends with a rollout to the end of the game.
+//
+//Search stops when Iterations iterations have run, Budget has elapsed or
+//the context is cancelled, whichever comes first. If both Iterations and
+//Budget are 0, DefaultIterations is used.
+type Planner struct {
+	Model      ChanceModel
+	Iterations int
+	Budget     time.Duration
+	//Exploration is the UCT exploration constant. If it is 0, math.Sqrt2
+	//is used.
+	Exploration float64
+	//Agents returns the rollout agents of each seat. If it is nil, every
+	//seat is a RandomAgent.
+	Agents func(r *rand.Rand) [4]Agent
+	Seed   int64
+	//Table holds the search tree by game state if it is not nil. States
+	//reached through different decisions then share their nodes, and
+	//later Plans reuse the search of earlier ones. Visits and wins are
+	//kept on the edges from each parent, so a shared node is scored by how
+	//it went from the parent being searched. The Table keeps a game clone
+	//for every state searched, see TranspositionTable.MaxStates. Plans
+	//sharing a Table must not run concurrently.
+	Table *TranspositionTable
+}
+
+//DefaultIterations is the number of iterations a Planner runs without an
+//iteration or time budget.
+const DefaultIterations = 1000
+
+//Candidate is a response to the planned decision.
+type Candidate struct {
+	Response mp1.Response
+	//WinProb is the estimated probability that the deciding player is in
+	//Game.Winners() at the end of the game after picking Response. With a
+	//Table, it includes the iterations of earlier Plans.
+	WinProb float64
+	//Visits is the number of iterations of this Plan that picked
+	//Response.
+	Visits int
+}
+
+//node is a node of the search tree. The edges of a node are indexed by
+//the response handled to follow them.
+type node struct {
+	//visits is the number of iterations that left the node, the sum of
+	//its edges' visits.
+	visits    int
+	responses []mp1.Response
+	edges     map[int]*edge
+}
+
+//edge leads from a node to the node reached by one of its responses.
+type edge struct {
+	child  *node
+	visits int
+	wins   [4]float64
+}
+
+//Plan searches from g's next event and returns a Candidate for each of
+//its responses, sorted by decreasing WinProb. g is left untouched.
+func (p Planner) Plan(ctx context.Context, g *mp1.Game) ([]Candidate, error) {
+	if g.NextEvent == nil {
+		return nil, mp1.ErrNoPendingEvent
+	}
+	player := g.NextEvent.ControllingPlayer()
+	if player == mp1.CPU_PLAYER {
+		return nil, ErrChanceEvent
+	}
+	if p.Model == nil {
+		p.Model = UniformModel{}
+	}
+	if p.Exploration == 0 {
+		p.Exploration = math.Sqrt2
+	}
+	if p.Iterations == 0 && p.Budget == 0 {
+		p.Iterations = DefaultIterations
+	}
+	r := rand.New(rand.NewSource(p.Seed))
+	rollout := Runner{Chance: ModelAgent{p.Model, r}}
+	if p.Agents != nil {
+		rollout.Players = p.Agents(r)
+	} else {
+		for i := range rollout.Players {
+			rollout.Players[i] = RandomAgent{r}
+		}
+	}
+
+	root, _ := p.lookup(g)
+	if root.responses == nil {
+		root.responses = g.NextEvent.Responses()
+	}
+	prior := make([]int, len(root.responses))
+	for i, e := range root.edges {
+		prior[i] = e.visits
+	}
+	start := time.Now()
+	for i := 0; p.Iterations == 0 || i < p.Iterations; i++ {
+		if p.Budget != 0 && time.Since(start) >= p.Budget {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			break
+		}
+		if err := p.iterate(root, g.Clone(), rollout, r); err != nil {
+			return nil, err
+		}
+	}
+
+	ret := make([]Candidate, len(root.responses))
+	for i, res := range root.responses {
+		ret[i].Response = res
+		if e, ok := root.edges[i]; ok {
+			ret[i].Visits = e.visits - prior[i]
+			ret[i].WinProb = e.wins[player] / float64(e.visits)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].WinProb > ret[j].WinProb
+	})
+	return ret, nil
+}
+
+//Choose returns the response to e with the best estimated win
+//probability, so a Planner can be used as a seat's Agent. If e cannot be
+//planned, its first response is returned.
+func (p Planner) Choose(g *mp1.Game, e mp1.Event) mp1.Response {
+	cands, err := p.Plan(context.Background(), g)
+	if err != nil || len(cands) == 0 {
+		return e.Responses()[0]
+	}
+	return cands[0].Response
+}
+
+//iterate runs one search iteration on g, a clone of the root's game:
+//selection down the tree, expansion of one node, a rollout and
+//backpropagation of the winners.
+func (p Planner) iterate(root *node, g *mp1.Game, rollout Runner, r *rand.Rand) error {
+	var path []*edge
+	var parents []*node
+	n := root
+	for g.NextEvent != nil {
+		e := g.NextEvent
+		var idx int
+		var res mp1.Response
+		if player := e.ControllingPlayer(); player == mp1.CPU_PLAYER {
+			idx = pick(p.Model, g, e, r)
+			res = mp1.ResponseAt(e, idx)
+		} else {
+			if n.responses == nil {
+				n.responses = e.Responses()
+			}
+			idx = n.selectChild(player, p.Exploration, r)
+			res = n.responses[idx]
+		}
+		g.HandleEvent(res)
+		ed, ok := n.edges[idx]
+		if !ok {
+			if n.edges == nil {
+				n.edges = map[int]*edge{}
+			}
+			ed = &edge{}
+			ed.child, ok = p.lookup(g)
+			n.edges[idx] = ed
+		}
+		path = append(path, ed)
+		parents = append(parents, n)
+		n = ed.child
+		if !ok {
+			break
+		}
+	}
+	if err := rollout.Run(g); err != nil {
+		return err
+	}
+	winners := g.Winners()
+	for i, ed := range path {
+		parents[i].visits++
+		ed.visits++
+		for _, w := range winners {
+			ed.wins[w]++
+		}
+	}
+	return nil
+}
+
+//lookup returns the node of g's state, and true if it was found in
+//p.Table.
+func (p Planner) lookup(g *mp1.Game) (*node, bool) {
+	if p.Table == nil {
+		return &node{}, false
+	}
+	v, loaded := p.Table.LoadOrStore(g, &node{})
+	if n, ok := v.(*node); ok {
+		return n, loaded
+	}
+	return &node{}, false
+}
+
+//selectChild returns the index of the response player should try next,
+//using UCT. Untried responses are picked first, at random.
+func (n *node) selectChild(player int, c float64, r *rand.Rand) int {
+	var untried []int
+	for i := range n.responses {
+		if _, ok := n.edges[i]; !ok {
+			untried = append(untried, i)
+		}
+	}
+	if len(untried) > 0 {
+		return untried[r.Intn(len(untried))]
+	}
+	best, bestValue := 0, math.Inf(-1)
+	logVisits := math.Log(float64(n.visits))
+	for i := range n.responses {
+		e := n.edges[i]
+		value := e.wins[player]/float64(e.visits) +
+			c*math.Sqrt(logVisits/float64(e.visits))
+		if value > bestValue {
+			best, bestValue = i, value
+		}
+	}
+	return best
+}
@@ -0,0 +1,101 @@
+package sim
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//lastTurnBranchGame returns a game on the last turn where player 0 can
+//only win by taking the branch to the star.
+func lastTurnBranchGame() *mp1.Game {
+	g := mp1.InitializeGame(BranchBoard, mp1.GameConfig{
+		MaxTurns:     1,
+		NoBonusStars: true,
+	})
+	g.Players[0].CurrentSpace = mp1.ChainSpace{Chain: 0, Space: 1}
+	g.Players[0].Coins = 20
+	for i := 1; i < 4; i++ {
+		g.Players[i].Coins = 0
+	}
+	g.NextEvent = mp1.BranchEvent{
+		Player: 0,
+		Moves:  3,
+		Links:  (*BranchBoard.Links)[0],
+	}
+	return g
+}
+
+func TestPlannerPicksStar(t *testing.T) {
+	g := lastTurnBranchGame()
+	before := g.Clone()
+	p := Planner{Iterations: 200}
+	cands, err := p.Plan(context.Background(), g)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(cands) != 2 {
+		t.Fatalf("Expected 2 candidates, got: %v", cands)
+	}
+	expected := mp1.ChainSpace{Chain: 1, Space: 0}
+	if cands[0].Response != expected || cands[0].WinProb != 1 {
+		t.Errorf("Expected %v to win, got: %#v", expected, cands)
+	}
+	if cands[0].Visits+cands[1].Visits != 200 {
+		t.Errorf("Expected 200 visits, got: %#v", cands)
+	}
+	if p.Choose(g, g.NextEvent) != expected {
+		t.Errorf("Expected Choose to pick %v", expected)
+	}
+	if g.Players != before.Players || g.NextEvent != before.NextEvent {
+		t.Errorf("Expected game to be untouched")
+	}
+}
+
+func TestPlannerBudget(t *testing.T) {
+	g := lastTurnBranchGame()
+	p := Planner{Budget: 20 * time.Millisecond}
+	start := time.Now()
+	cands, err := p.Plan(context.Background(), g)
+	if err != nil || len(cands) != 2 {
+		t.Fatalf("Expected 2 candidates, got: %v, %v", cands, err)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("Expected search to stop after its budget")
+	}
+}
+
+func TestPlannerChanceEvent(t *testing.T) {
+	g := mp1.InitializeGame(BranchBoard, mp1.GameConfig{MaxTurns: 20})
+	if _, err := (Planner{}).Plan(context.Background(), g); err != ErrChanceEvent {
+		t.Errorf("Expected ErrChanceEvent, got: %v", err)
+	}
+}
+
+func TestPlannerBoards(t *testing.T) {
+	for _, name := range mp1.BoardNames() {
+		b, _ := mp1.LookupBoard(name)
+		r := rand.New(rand.NewSource(0))
+		runner := Runner{Chance: ModelAgent{HiddenBlockModel{}, r}}
+		for i := range runner.Players {
+			runner.Players[i] = RandomAgent{r}
+		}
+		g := mp1.InitializeGame(b, testConfig)
+		for g.NextEvent != nil &&
+			g.NextEvent.ControllingPlayer() == mp1.CPU_PLAYER {
+			runner.Step(g)
+		}
+		p := Planner{Iterations: 20}
+		cands, err := p.Plan(context.Background(), g)
+		if err != nil {
+			t.Errorf("%s: Expected no error, got: %v", name, err)
+		}
+		if len(cands) != len(g.NextEvent.Responses()) {
+			t.Errorf("%s: Expected a candidate per response, got: %v",
+				name, cands)
+		}
+	}
+}
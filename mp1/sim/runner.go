@@ -0,0 +1,47 @@
+package sim
+
+import "github.com/0xhexnumbers/partysim/mp1"
+
+//Runner plays games by asking each event's ControllingPlayer for a
+//response.
+type Runner struct {
+	//Players holds the agent of each seat.
+	Players [4]Agent
+	//Chance responds to events controlled by mp1.CPU_PLAYER.
+	Chance Agent
+}
+
+//Agent returns the agent responding to e.
+func (r Runner) Agent(e mp1.Event) Agent {
+	player := e.ControllingPlayer()
+	if player == mp1.CPU_PLAYER {
+		return r.Chance
+	}
+	return r.Players[player]
+}
+
+//Step handles g's next event with the response of its agent. It returns
+//mp1.ErrNoPendingEvent if the game is over, and an
+//*mp1.InvalidResponseError if the agent returned an invalid response.
+func (r Runner) Step(g *mp1.Game) error {
+	e := g.NextEvent
+	if e == nil {
+		return mp1.ErrNoPendingEvent
+	}
+	res := r.Agent(e).Choose(g, e)
+	if !mp1.ValidResponse(e, res) {
+		return &mp1.InvalidResponseError{Event: e, Response: res}
+	}
+	g.HandleEvent(res)
+	return nil
+}
+
+//Run plays g to completion.
+func (r Runner) Run(g *mp1.Game) error {
+	for g.NextEvent != nil {
+		if err := r.Step(g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
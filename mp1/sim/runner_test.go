@@ -0,0 +1,129 @@
+package sim
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	"github.com/0xhexnumbers/partysim/mp1/board"
+)
+
+type constAgent struct {
+	res mp1.Response
+}
+
+func (a constAgent) Choose(g *mp1.Game, e mp1.Event) mp1.Response {
+	return a.res
+}
+
+func TestRunnerRun(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	runner := Runner{Chance: ModelAgent{HiddenBlockModel{}, r}}
+	runner.Players[0] = StarChaser{r}
+	runner.Players[1] = GreedyCoins{r}
+	runner.Players[2] = BooStarStealer{RandomAgent{r}}
+	runner.Players[3] = RandomAgent{r}
+	g := mp1.InitializeGame(board.MRC, testConfig)
+	if err := runner.Run(g); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if g.Turn != testConfig.MaxTurns {
+		t.Errorf("Expected game to finish, got turn: %d", g.Turn)
+	}
+	if err := runner.Step(g); err != mp1.ErrNoPendingEvent {
+		t.Errorf("Expected ErrNoPendingEvent, got: %v", err)
+	}
+}
+
+func TestRunnerInvalidResponse(t *testing.T) {
+	runner := Runner{Chance: constAgent{99}}
+	g := mp1.InitializeGame(board.MRC, testConfig)
+	before := g.Clone()
+	if err := runner.Step(g); !errors.Is(err, mp1.ErrInvalidResponse) {
+		t.Errorf("Expected invalid response error, got: %v", err)
+	}
+	if g.NextEvent != before.NextEvent {
+		t.Errorf("Expected game to be untouched")
+	}
+}
+
+func TestSimulateAgents(t *testing.T) {
+	opts := Options{
+		Board:  board.PBC,
+		Config: testConfig,
+		Games:  5,
+		Agents: func(r *rand.Rand) [4]Agent {
+			return [4]Agent{
+				StarChaser{r}, GreedyCoins{r},
+				BooStarStealer{RandomAgent{r}}, RandomAgent{r},
+			}
+		},
+	}
+	res, err := Simulate(context.Background(), opts)
+	if err != nil || res.Games != 5 {
+		t.Errorf("Expected 5 games, got: %d, %v", res.Games, err)
+	}
+}
+
+//responsesAgent picks uniformly from the slice built by Responses, as
+//RandomAgent did before events were indexed.
+type responsesAgent struct {
+	Rand *rand.Rand
+}
+
+func (a responsesAgent) Choose(g *mp1.Game, e mp1.Event) mp1.Response {
+	res := e.Responses()
+	return res[a.Rand.Intn(len(res))]
+}
+
+//responsesModelAgent samples from the slice built by Responses, as
+//ModelAgent did before events were indexed.
+type responsesModelAgent struct {
+	Model ChanceModel
+	Rand  *rand.Rand
+}
+
+func (a responsesModelAgent) Choose(g *mp1.Game, e mp1.Event) mp1.Response {
+	res := e.Responses()
+	return res[sampleIndex(a.Model, g, e, res, a.Rand)]
+}
+
+//benchmarkGames plays games of every board with runner, reporting games
+//per second.
+func benchmarkGames(b *testing.B, runner Runner) {
+	boards := []mp1.Board{
+		board.BMM, board.DKJA, board.ES, board.LER,
+		board.MRC, board.PBC, board.WBC, board.YTI,
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	start := time.Now()
+	for n := 0; n < b.N; n++ {
+		g := mp1.InitializeGame(boards[n%len(boards)], testConfig)
+		if err := runner.Run(g); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(b.N)/time.Since(start).Seconds(), "games/s")
+}
+
+func BenchmarkRandomGames(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	runner := Runner{Chance: ModelAgent{HiddenBlockModel{}, r}}
+	for i := range runner.Players {
+		runner.Players[i] = RandomAgent{r}
+	}
+	benchmarkGames(b, runner)
+}
+
+func BenchmarkRandomGamesResponses(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	runner := Runner{Chance: responsesModelAgent{HiddenBlockModel{}, r}}
+	for i := range runner.Players {
+		runner.Players[i] = responsesAgent{r}
+	}
+	benchmarkGames(b, runner)
+}
@@ -0,0 +1,254 @@
+//Package sim runs batches of randomly played mp1 games and aggregates
+//their results.
+package sim
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//Options configures a batch of simulated games.
+type Options struct {
+	Board  mp1.Board
+	Config mp1.GameConfig
+	//Start is the state every game is played from. If it is nil, games
+	//start from mp1.InitializeGame(Board, Config).
+	Start *mp1.Game
+
+	//Games is the number of games to simulate.
+	Games int
+	//Workers is the number of goroutines playing games. If it is <= 0,
+	//runtime.GOMAXPROCS(0) workers are used.
+	Workers int
+	//Seed seeds the RNG of every game. Game i is played with its own RNG
+	//seeded with Seed+i, so results only depend on Seed and Games, not on
+	//the number of workers.
+	Seed int64
+	//Model picks the responses of events controlled by mp1.CPU_PLAYER.
+	//If it is nil, UniformModel is used.
+	Model ChanceModel
+	//Agents returns the agents of each seat for a game played with r. If
+	//it is nil, every seat is a RandomAgent.
+	Agents func(r *rand.Rand) [4]Agent
+}
+
+//Distribution is a histogram of integer values.
+type Distribution struct {
+	Counts map[int]int
+}
+
+//Add records one occurrence of v.
+func (d *Distribution) Add(v int) {
+	if d.Counts == nil {
+		d.Counts = map[int]int{}
+	}
+	d.Counts[v]++
+}
+
+//Total returns the number of recorded values.
+func (d Distribution) Total() int {
+	total := 0
+	for _, c := range d.Counts {
+		total += c
+	}
+	return total
+}
+
+//Mean returns the average of the recorded values, or 0 if there are none.
+func (d Distribution) Mean() float64 {
+	total, sum := 0, 0
+	for v, c := range d.Counts {
+		total += c
+		sum += v * c
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(sum) / float64(total)
+}
+
+//Min returns the smallest recorded value, or 0 if there are none.
+func (d Distribution) Min() int {
+	first := true
+	ret := 0
+	for v := range d.Counts {
+		if first || v < ret {
+			ret = v
+			first = false
+		}
+	}
+	return ret
+}
+
+//Max returns the largest recorded value, or 0 if there are none.
+func (d Distribution) Max() int {
+	first := true
+	ret := 0
+	for v := range d.Counts {
+		if first || v > ret {
+			ret = v
+			first = false
+		}
+	}
+	return ret
+}
+
+//Result holds the aggregated results of a batch of games.
+type Result struct {
+	//Games is the number of games played to completion.
+	Games int
+	//Wins counts the games each seat finished in Game.Winners(). Every
+	//player in a tie is counted as a winner.
+	Wins [4]int
+	//Stars and Coins are the final star and coin counts of each seat.
+	Stars [4]Distribution
+	Coins [4]Distribution
+	//BonusStars is the total number of bonus stars each seat received.
+	BonusStars [4]int
+	//Length is the number of events handled in each game, from
+	//Options.Start if it is set.
+	Length Distribution
+}
+
+//WinRate returns the fraction of games won by player.
+func (r *Result) WinRate(player int) float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return float64(r.Wins[player]) / float64(r.Games)
+}
+
+//AvgBonusStars returns the average number of bonus stars player received
+//per game.
+func (r *Result) AvgBonusStars(player int) float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return float64(r.BonusStars[player]) / float64(r.Games)
+}
+
+//add aggregates a single game into r.
+func (r *Result) add(gr gameResult) {
+	r.Games++
+	for _, w := range gr.Winners {
+		r.Wins[w]++
+	}
+	for i := range gr.Players {
+		r.Stars[i].Add(gr.Players[i].Stars)
+		r.Coins[i].Add(gr.Players[i].Coins)
+		r.BonusStars[i] += gr.BonusStars[i]
+	}
+	r.Length.Add(gr.Events)
+}
+
+//gameResult is the outcome of a single game.
+type gameResult struct {
+	Players    [4]mp1.Player
+	Winners    []int
+	BonusStars [4]int
+	Events     int
+}
+
+//Simulate plays opts.Games games and aggregates their results. Responses
+//to CPU_PLAYER events are sampled from opts.Model, every other response
+//is picked by the seat's agent. If ctx is cancelled, the games completed
+//so far are returned along with ctx's error. A negative number of games
+//is an error.
+func Simulate(ctx context.Context, opts Options) (*Result, error) {
+	if opts.Games < 0 {
+		return nil, fmt.Errorf("sim: negative number of games %d",
+			opts.Games)
+	}
+	if opts.Model == nil {
+		opts.Model = UniformModel{}
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	results := make([]*gameResult, opts.Games)
+	errs := make([]error, opts.Games)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				r := rand.New(rand.NewSource(opts.Seed + int64(i)))
+				results[i], errs[i] = playGame(ctx, opts, r)
+			}
+		}()
+	}
+feed:
+	for i := 0; i < opts.Games; i++ {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	res := &Result{}
+	for i, gr := range results {
+		if errs[i] != nil && errs[i] != ctx.Err() {
+			return res, fmt.Errorf("sim: game %d (seed %d): %v",
+				i, opts.Seed+int64(i), errs[i])
+		}
+		if gr != nil {
+			res.add(*gr)
+		}
+	}
+	return res, ctx.Err()
+}
+
+//playGame plays a single game to completion using r for every random
+//choice.
+func playGame(ctx context.Context, opts Options, r *rand.Rand) (gr *gameResult, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			gr = nil
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+	var g *mp1.Game
+	if opts.Start != nil {
+		g = opts.Start.Clone()
+	} else {
+		g = mp1.InitializeGame(opts.Board, opts.Config)
+	}
+	ret := &gameResult{}
+	g.AddListener(mp1.ListenerFunc(func(g *mp1.Game, e mp1.GameEvent) {
+		//Bonus stars are the only stars awarded after the last turn
+		if s, ok := e.(mp1.StarsChanged); ok && g.Turn == g.Config.MaxTurns {
+			ret.BonusStars[s.Player] += s.Delta
+		}
+	}))
+	runner := Runner{Chance: ModelAgent{opts.Model, r}}
+	if opts.Agents != nil {
+		runner.Players = opts.Agents(r)
+	} else {
+		for i := range runner.Players {
+			runner.Players[i] = RandomAgent{r}
+		}
+	}
+	for g.NextEvent != nil {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := runner.Step(g); err != nil {
+			return nil, err
+		}
+		ret.Events++
+	}
+	ret.Players = g.Players
+	ret.Winners = g.Winners()
+	return ret, nil
+}
@@ -0,0 +1,93 @@
+package sim
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	"github.com/0xhexnumbers/partysim/mp1/board"
+)
+
+var testConfig = mp1.GameConfig{
+	MaxTurns:   20,
+	RedDice:    true,
+	BlueDice:   true,
+	WarpDice:   true,
+	EventsDice: true,
+}
+
+func TestSimulateDeterministic(t *testing.T) {
+	opts := Options{Board: board.MRC, Config: testConfig, Games: 20, Seed: 7}
+	opts.Workers = 1
+	single, err := Simulate(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	opts.Workers = 4
+	multi, err := Simulate(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(single, multi) {
+		t.Errorf("Expected equal results, got:\n%#v\n%#v", single, multi)
+	}
+}
+
+func TestSimulateAggregates(t *testing.T) {
+	opts := Options{Board: board.YTI, Config: testConfig, Games: 10, Seed: 1}
+	res, err := Simulate(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if res.Games != 10 {
+		t.Errorf("Expected 10 games, got: %d", res.Games)
+	}
+	wins := 0
+	for i := 0; i < 4; i++ {
+		wins += res.Wins[i]
+		if res.Stars[i].Total() != 10 || res.Coins[i].Total() != 10 {
+			t.Errorf("Expected 10 star and coin results for player %d", i)
+		}
+		if res.BonusStars[i] < 0 || res.BonusStars[i] > 30 {
+			t.Errorf("Expected 0-30 bonus stars for player %d, got: %d",
+				i, res.BonusStars[i])
+		}
+	}
+	if wins < 10 {
+		t.Errorf("Expected at least 10 wins, got: %d", wins)
+	}
+	if res.Length.Min() <= 0 {
+		t.Errorf("Expected positive game lengths, got: %v", res.Length)
+	}
+}
+
+func TestSimulateCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	opts := Options{Board: board.MRC, Config: testConfig, Games: 100}
+	res, err := Simulate(ctx, opts)
+	if err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	}
+	if res.Games != 0 {
+		t.Errorf("Expected no games, got: %d", res.Games)
+	}
+}
+
+func TestSimulateNegativeGames(t *testing.T) {
+	opts := Options{Board: board.MRC, Config: testConfig, Games: -1}
+	if _, err := Simulate(context.Background(), opts); err == nil {
+		t.Errorf("Expected an error for a negative number of games")
+	}
+}
+
+func TestDistribution(t *testing.T) {
+	var d Distribution
+	for _, v := range []int{3, 1, 3, 5} {
+		d.Add(v)
+	}
+	if d.Total() != 4 || d.Mean() != 3 || d.Min() != 1 || d.Max() != 5 {
+		t.Errorf("Unexpected distribution stats: %v", d)
+	}
+}
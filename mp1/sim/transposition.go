@@ -0,0 +1,106 @@
+package sim
+
+import (
+	"sync"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//TranspositionTable maps game states to values, so states reached in
+//different ways, or by different games, are only evaluated once. States
+//are looked up by mp1.Game.Hash and told apart by mp1.Game.Equal, so
+//hash collisions never return another state's value. A table is safe for
+//concurrent use and should only hold games played on the same board.
+//
+//Every state holds a clone of its game, and nothing is ever removed, so a
+//table grows with every new state stored. Set MaxStates to bound it, or
+//drop the table once its games are over.
+type TranspositionTable struct {
+	//MaxStates is the most states the table stores, or 0 for no limit.
+	//Once it is reached, new states are not stored. It must be set before
+	//the table is used.
+	MaxStates int
+
+	mu      sync.Mutex
+	entries map[uint64][]transposition
+	size    int
+}
+
+//transposition is a stored state and its value.
+type transposition struct {
+	game  *mp1.Game
+	value interface{}
+}
+
+//NewTranspositionTable returns an empty TranspositionTable.
+func NewTranspositionTable() *TranspositionTable {
+	return &TranspositionTable{entries: map[uint64][]transposition{}}
+}
+
+//Lookup returns the value stored for g's state, or false if there is
+//none.
+func (t *TranspositionTable) Lookup(g *mp1.Game) (interface{}, bool) {
+	hash := g.Hash()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, e := range t.entries[hash] {
+		if e.game.Equal(g) {
+			return e.value, true
+		}
+	}
+	return nil, false
+}
+
+//Store sets the value of g's state, replacing any value stored before. g
+//is cloned, so it can keep being played.
+func (t *TranspositionTable) Store(g *mp1.Game, value interface{}) {
+	hash := g.Hash()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	entries := t.entries[hash]
+	for i, e := range entries {
+		if e.game.Equal(g) {
+			entries[i].value = value
+			return
+		}
+	}
+	if t.full() {
+		return
+	}
+	t.entries[hash] = append(entries, transposition{g.Clone(), value})
+	t.size++
+}
+
+//LoadOrStore returns the value stored for g's state if there is one.
+//Otherwise it stores value, unless the table is full, and returns it.
+//loaded is true if the value was already stored.
+func (t *TranspositionTable) LoadOrStore(g *mp1.Game, value interface{}) (actual interface{}, loaded bool) {
+	hash := g.Hash()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	entries := t.entries[hash]
+	for _, e := range entries {
+		if e.game.Equal(g) {
+			return e.value, true
+		}
+	}
+	if t.full() {
+		return value, false
+	}
+	t.entries[hash] = append(entries, transposition{g.Clone(), value})
+	t.size++
+	return value, false
+}
+
+//full returns true if the table holds MaxStates states. t.mu must be
+//held.
+func (t *TranspositionTable) full() bool {
+	return t.MaxStates > 0 && t.size >= t.MaxStates
+}
+
+//Len returns the number of states stored.
+func (t *TranspositionTable) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.size
+}
@@ -0,0 +1,120 @@
+package sim
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	"github.com/0xhexnumbers/partysim/mp1/board"
+)
+
+func TestTranspositionTable(t *testing.T) {
+	table := NewTranspositionTable()
+	g := mp1.InitializeGame(board.MRC, testConfig)
+	if _, ok := table.Lookup(g); ok {
+		t.Errorf("Expected empty table")
+	}
+	table.Store(g, 1)
+	g.Players[0].Coins++ //Stored games are clones
+	if _, ok := table.Lookup(g); ok {
+		t.Errorf("Expected changed game not to be found")
+	}
+	g.Players[0].Coins--
+	if v, ok := table.Lookup(g.Clone()); !ok || v != 1 {
+		t.Errorf("Expected 1, got: %v, %v", v, ok)
+	}
+	table.Store(g, 2)
+	if v, loaded := table.LoadOrStore(g, 3); !loaded || v != 2 {
+		t.Errorf("Expected 2 to be loaded, got: %v, %v", v, loaded)
+	}
+	if table.Len() != 1 {
+		t.Errorf("Expected 1 state, got: %d", table.Len())
+	}
+}
+
+func TestTranspositionTableMaxStates(t *testing.T) {
+	table := NewTranspositionTable()
+	table.MaxStates = 2
+	g := mp1.InitializeGame(board.MRC, testConfig)
+	for turn := 0; turn < 3; turn++ {
+		table.Store(g, turn)
+		g.Turn++
+	}
+	if table.Len() != 2 {
+		t.Errorf("Expected 2 states, got: %d", table.Len())
+	}
+	if _, ok := table.Lookup(g); ok {
+		t.Errorf("Expected new state not to be stored")
+	}
+	if v, loaded := table.LoadOrStore(g, 4); loaded || v != 4 {
+		t.Errorf("Expected 4 not to be loaded, got: %v, %v", v, loaded)
+	}
+	g.Turn = 1
+	table.Store(g, 5) //Stored states can still be replaced
+	if v, ok := table.Lookup(g); !ok || v != 5 {
+		t.Errorf("Expected 5, got: %v, %v", v, ok)
+	}
+}
+
+func TestTranspositionTableConcurrent(t *testing.T) {
+	table := NewTranspositionTable()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			g := mp1.InitializeGame(board.ES, testConfig)
+			for turn := 0; turn < 3; turn++ {
+				table.LoadOrStore(g, turn)
+				g.Turn++
+			}
+		}()
+	}
+	wg.Wait()
+	if table.Len() != 3 {
+		t.Errorf("Expected 3 states, got: %d", table.Len())
+	}
+}
+
+func TestPlannerTable(t *testing.T) {
+	g := lastTurnBranchGame()
+	p := Planner{Iterations: 100, Table: NewTranspositionTable()}
+	if _, err := p.Plan(context.Background(), g); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	cands, err := p.Plan(context.Background(), g)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	expected := mp1.ChainSpace{Chain: 1, Space: 0}
+	if cands[0].Response != expected {
+		t.Errorf("Expected %v to win, got: %#v", expected, cands)
+	}
+	//The second plan continues the search of the first, but only counts
+	//its own visits
+	if cands[0].Visits+cands[1].Visits != 100 {
+		t.Errorf("Expected 100 visits, got: %#v", cands)
+	}
+}
+
+func TestPlannerTableChoice(t *testing.T) {
+	g := lastTurnBranchGame()
+	expected := mp1.ChainSpace{Chain: 1, Space: 0}
+	tables := []*TranspositionTable{nil, NewTranspositionTable()}
+	for _, table := range tables {
+		p := Planner{Iterations: 200, Table: table}
+		cands, err := p.Plan(context.Background(), g)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if cands[0].Response != expected {
+			t.Errorf("Expected %v to win with table %v, got: %#v",
+				expected, table != nil, cands)
+		}
+		if cands[0].Visits <= cands[1].Visits {
+			t.Errorf("Expected %v to be visited most with table %v, "+
+				"got: %#v", expected, table != nil, cands)
+		}
+	}
+}
@@ -42,14 +42,34 @@ func (s StarLocationEvent) Type() EventType {
 //Responses returns a slice of the available indexes of the available star
 //spaces.
 func (s StarLocationEvent) Responses() []Response {
-	var i int
-	res := []Response{}
-	for i = 0; i < int(s.StarSpaceCount); i++ {
-		if s.RelativeVisited&(1<<i) == 0 {
-			res = append(res, (*s.IndexToPosition)[i])
+	return responses(s)
+}
+
+func (s StarLocationEvent) ResponseCount() int {
+	_, count := s.position(-1)
+	return count
+}
+
+func (s StarLocationEvent) ResponseAt(i int) Response {
+	pos, count := s.position(i)
+	if i < 0 || i >= count {
+		panic("mp1: StarLocationEvent response index out of range")
+	}
+	return pos
+}
+
+//position returns the ith star space that has not been visited and the
+//number of star spaces that have not been visited.
+func (s StarLocationEvent) position(i int) (pos ChainSpace, count int) {
+	for j := 0; j < int(s.StarSpaceCount); j++ {
+		if s.RelativeVisited&(1<<j) == 0 {
+			if count == i {
+				pos = (*s.IndexToPosition)[j]
+			}
+			count++
 		}
 	}
-	return res
+	return pos, count
 }
 
 func (s StarLocationEvent) ControllingPlayer() int {
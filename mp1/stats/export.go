@@ -0,0 +1,115 @@
+package stats
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//landedTypes are the space types players can be activated on, in the
+//order of the CSV columns.
+var landedTypes = []mp1.SpaceType{
+	mp1.Blue, mp1.Red, mp1.MinigameSpace, mp1.Happening, mp1.Chance,
+	mp1.Mushroom, mp1.Bowser,
+}
+
+//WriteCSV writes the timeline as CSV to w, with a header and a row per
+//player per turn. Rows have every Player field, with CurrentSpace split
+//into Chain and Space, followed by the player's landings so far on each
+//type of space.
+func (r *Recorder) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{
+		"Turn", "Player", "Char", "Stars", "Coins", "Chain", "Space",
+		"SkipTurn", "LastSpaceType", "MaxCoins", "HappeningCount",
+		"MinigameCoins",
+	}
+	for _, t := range landedTypes {
+		header = append(header, "Landed"+t.String())
+	}
+	cw.Write(header)
+	for _, turn := range r.Turns {
+		for i, p := range turn.Players {
+			row := []string{
+				strconv.Itoa(int(turn.Turn)), strconv.Itoa(i), p.Char,
+				strconv.Itoa(p.Stars), strconv.Itoa(p.Coins),
+				strconv.Itoa(p.CurrentSpace.Chain),
+				strconv.Itoa(p.CurrentSpace.Space),
+				strconv.FormatBool(p.SkipTurn), p.LastSpaceType.String(),
+				strconv.Itoa(p.MaxCoins), strconv.Itoa(p.HappeningCount),
+				strconv.Itoa(p.MinigameCoins),
+			}
+			for _, t := range landedTypes {
+				row = append(row, strconv.Itoa(turn.Landings[i][t]))
+			}
+			cw.Write(row)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+//WriteMinigamesCSV writes the minigames played as CSV to w, with a
+//header and a row per minigame giving the coins each player won or lost.
+func (r *Recorder) WriteMinigamesCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"Turn", "Minigame", "Coins1", "Coins2", "Coins3", "Coins4"})
+	for _, m := range r.Minigames {
+		row := []string{strconv.Itoa(int(m.Turn)), fmt.Sprint(m.Game)}
+		for _, c := range m.Coins {
+			row = append(row, strconv.Itoa(c))
+		}
+		cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+//turnLine is a line of WriteJSONL.
+type turnLine struct {
+	Turn    uint8
+	Players [4]mp1.Player
+	//Landings are keyed by space type name.
+	Landings  [4]map[string]int
+	Minigames []minigameLine
+}
+
+//minigameLine is a minigame of a turnLine.
+type minigameLine struct {
+	Minigame string
+	Coins    [4]int
+}
+
+//WriteJSONL writes the timeline as JSON Lines to w, with an object per
+//turn holding the players, their landings so far by space type name and
+//the minigames played during the turn.
+func (r *Recorder) WriteJSONL(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, turn := range r.Turns {
+		line := turnLine{
+			Turn:      turn.Turn,
+			Players:   turn.Players,
+			Minigames: []minigameLine{},
+		}
+		for i, l := range turn.Landings {
+			line.Landings[i] = map[string]int{}
+			for t, n := range l {
+				line.Landings[i][t.String()] = n
+			}
+		}
+		for _, m := range r.Minigames {
+			if m.Turn == turn.Turn {
+				line.Minigames = append(line.Minigames,
+					minigameLine{fmt.Sprint(m.Game), m.Coins})
+			}
+		}
+		if err := enc.Encode(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
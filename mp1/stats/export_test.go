@@ -0,0 +1,82 @@
+package stats
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+)
+
+func TestWriteCSV(t *testing.T) {
+	_, r := RecordedTurn()
+	var buf bytes.Buffer
+	if err := r.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 9 {
+		t.Fatalf("Expected a header and 8 rows, got: %d", len(rows))
+	}
+	header := rows[0]
+	if header[0] != "Turn" || header[4] != "Coins" ||
+		header[len(header)-1] != "LandedBowser" {
+		t.Errorf("Unexpected header: %v", header)
+	}
+	//Player 2 after turn 1
+	row := rows[7]
+	if row[0] != "1" || row[1] != "2" || row[4] != "20" {
+		t.Errorf("Expected player 2 with 20 coins after turn 1, got: %v", row)
+	}
+	//Player 1 after turn 1 landed on Blue then Red
+	row = rows[6]
+	if row[12] != "1" || row[13] != "1" || row[14] != "0" {
+		t.Errorf("Expected player 1 to have landed on Blue and Red, got: %v",
+			row)
+	}
+}
+
+func TestWriteMinigamesCSV(t *testing.T) {
+	_, r := RecordedTurn()
+	var buf bytes.Buffer
+	if err := r.WriteMinigamesCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := "Turn,Minigame,Coins1,Coins2,Coins3,Coins4\n" +
+		"1,Burried Treasure,0,0,10,0\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestWriteJSONL(t *testing.T) {
+	g, r := RecordedTurn()
+	var buf bytes.Buffer
+	if err := r.WriteJSONL(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var lines []turnLine
+	s := bufio.NewScanner(&buf)
+	for s.Scan() {
+		var line turnLine
+		if err := json.Unmarshal(s.Bytes(), &line); err != nil {
+			t.Fatalf("Expected a JSON object per line, got: %v", err)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got: %d", len(lines))
+	}
+	if len(lines[0].Minigames) != 0 || len(lines[1].Minigames) != 1 {
+		t.Errorf("Expected the minigame in turn 1, got: %+v", lines)
+	}
+	if lines[1].Players != g.Players {
+		t.Errorf("Expected players %+v, got: %+v", g.Players, lines[1].Players)
+	}
+	if n := lines[1].Landings[1]["Red"]; n != 1 {
+		t.Errorf("Expected player 1 to have landed on Red once, got: %d", n)
+	}
+}
@@ -0,0 +1,90 @@
+//Package stats records the timeline of an mp1 game, turn by turn, and
+//exports it as CSV or JSON Lines.
+package stats
+
+import "github.com/0xhexnumbers/partysim/mp1"
+
+//Landings counts how many times a player landed on each type of space,
+//by the LastSpaceType the space was activated as.
+type Landings map[mp1.SpaceType]int
+
+//Turn is the state of the game at the end of a turn.
+type Turn struct {
+	//Turn is the number of turns played, 0 for the state the recording
+	//started from.
+	Turn    uint8
+	Players [4]mp1.Player
+	//Landings are the landings of each player so far.
+	Landings [4]Landings
+}
+
+//Minigame is a minigame played and the coins it gave or took from each
+//player.
+type Minigame struct {
+	//Turn is the turn the minigame was played in, counting from 1.
+	Turn uint8
+	//Game is a MinigameFFAGame, Minigame2V2Game, Minigame1V3Game,
+	//Minigame1PGame or, for Bowser's minigames, a BowserResponse.
+	Game  mp1.Response
+	Coins [4]int
+}
+
+//Recorder is a Listener recording the timeline of a game.
+type Recorder struct {
+	//Turns has a snapshot of the game at the start of the recording and
+	//after every turn.
+	Turns     []Turn
+	Minigames []Minigame
+
+	landings [4]Landings
+}
+
+//NewRecorder returns a Recorder listening to g, starting with a snapshot
+//of g's current state.
+func NewRecorder(g *mp1.Game) *Recorder {
+	r := &Recorder{}
+	for i := range r.landings {
+		r.landings[i] = Landings{}
+	}
+	r.snapshot(g)
+	g.AddListener(r)
+	return r
+}
+
+//Landings returns the landings of each player so far.
+func (r *Recorder) Landings() [4]Landings {
+	return r.copyLandings()
+}
+
+//Notify records e.
+func (r *Recorder) Notify(g *mp1.Game, e mp1.GameEvent) {
+	switch e := e.(type) {
+	case mp1.SpaceLanded:
+		r.landings[e.Player][e.Type]++
+	case mp1.MinigamePlayed:
+		r.Minigames = append(r.Minigames, Minigame{
+			Turn:  g.Turn + 1,
+			Game:  e.Minigame,
+			Coins: e.Coins,
+		})
+	case mp1.TurnEnded:
+		r.snapshot(g)
+	}
+}
+
+//snapshot appends the current state of g to r.Turns.
+func (r *Recorder) snapshot(g *mp1.Game) {
+	r.Turns = append(r.Turns, Turn{g.Turn, g.Players, r.copyLandings()})
+}
+
+//copyLandings returns a copy of the landings so far.
+func (r *Recorder) copyLandings() [4]Landings {
+	var ret [4]Landings
+	for i, l := range r.landings {
+		ret[i] = Landings{}
+		for t, n := range l {
+			ret[i][t] = n
+		}
+	}
+	return ret
+}
@@ -0,0 +1,97 @@
+package stats
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	"github.com/0xhexnumbers/partysim/mp1/board"
+	"github.com/0xhexnumbers/partysim/mp1/sim"
+)
+
+//RecordedTurn plays a turn of made up events on a new Recorder.
+func RecordedTurn() (*mp1.Game, *Recorder) {
+	g := mp1.InitializeGame(board.MRC, mp1.GameConfig{MaxTurns: 20})
+	r := NewRecorder(g)
+	r.Notify(g, mp1.SpaceLanded{Player: 0, Type: mp1.Blue})
+	r.Notify(g, mp1.SpaceLanded{Player: 1, Type: mp1.Blue})
+	r.Notify(g, mp1.SpaceLanded{Player: 1, Type: mp1.Red})
+	r.Notify(g, mp1.CoinsChanged{Player: 2, Delta: 10, Coins: 20, Minigame: true})
+	r.Notify(g, mp1.MinigamePlayed{
+		Minigame: mp1.MinigameFFABurriedTreasure,
+		Coins:    [4]int{0, 0, 10, 0},
+	})
+	r.Notify(g, mp1.CoinsChanged{Player: 3, Delta: 3, Coins: 13})
+	g.Turn++
+	g.Players[2].Coins = 20
+	r.Notify(g, mp1.TurnEnded{Turn: g.Turn})
+	return g, r
+}
+
+func TestRecorder(t *testing.T) {
+	g, r := RecordedTurn()
+	if len(r.Turns) != 2 {
+		t.Fatalf("Expected 2 snapshots, got: %d", len(r.Turns))
+	}
+	if r.Turns[0].Turn != 0 || r.Turns[0].Players[2].Coins != 10 {
+		t.Errorf("Expected the starting state first, got: %+v", r.Turns[0])
+	}
+	if r.Turns[1].Turn != 1 || r.Turns[1].Players != g.Players {
+		t.Errorf("Expected the state after turn 1, got: %+v", r.Turns[1])
+	}
+
+	expected := [4]Landings{
+		{mp1.Blue: 1}, {mp1.Blue: 1, mp1.Red: 1}, {}, {},
+	}
+	if !reflect.DeepEqual(expected, r.Turns[1].Landings) ||
+		!reflect.DeepEqual(expected, r.Landings()) {
+		t.Errorf("Expected landings %v, got: %v", expected, r.Turns[1].Landings)
+	}
+	if !reflect.DeepEqual([4]Landings{{}, {}, {}, {}}, r.Turns[0].Landings) {
+		t.Errorf("Expected no landings at the start, got: %v",
+			r.Turns[0].Landings)
+	}
+
+	minigames := []Minigame{
+		{1, mp1.MinigameFFABurriedTreasure, [4]int{0, 0, 10, 0}},
+	}
+	if !reflect.DeepEqual(minigames, r.Minigames) {
+		t.Errorf("Expected minigames %v, got: %v", minigames, r.Minigames)
+	}
+}
+
+func TestRecorderGame(t *testing.T) {
+	g := mp1.InitializeGame(board.MRC, mp1.GameConfig{MaxTurns: 10})
+	r := NewRecorder(g)
+	rng := rand.New(rand.NewSource(1))
+	runner := sim.Runner{Chance: sim.RandomAgent{Rand: rng}}
+	for i := range runner.Players {
+		runner.Players[i] = sim.RandomAgent{Rand: rng}
+	}
+	if err := runner.Run(g); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(r.Turns) != 11 {
+		t.Fatalf("Expected 11 snapshots, got: %d", len(r.Turns))
+	}
+	last := r.Turns[10]
+	if last.Turn != 10 || last.Players != g.Players {
+		t.Errorf("Expected the final state last, got: %+v", last)
+	}
+	//Every player lands once per turn, unless they skip it
+	for i, l := range last.Landings {
+		total := 0
+		for _, n := range l {
+			total += n
+		}
+		if total == 0 || total > 10 {
+			t.Errorf("Expected player %d to land 1 to 10 times, got: %v", i, l)
+		}
+	}
+	if len(r.Minigames) < 10 {
+		t.Errorf("Expected at least a minigame per turn, got: %d",
+			len(r.Minigames))
+	}
+}
@@ -0,0 +1,264 @@
+package mp1
+
+import "fmt"
+
+//maxStarSpaces is the number of star spaces StarData's uint64 bitmasks
+//can track.
+const maxStarSpaces = 64
+
+//ValidateBoard checks b for mistakes that would otherwise only show up
+//as panics or stuck players in the middle of a game. It returns every
+//problem found, or nil if there are none. It checks that:
+//
+//	every chain has spaces and every link and Exit points to a space
+//	Happening spaces have a StoppingEvent, and Invisible spaces players
+//	can land on have one too, which sets the landed space type when run
+//	on a new game
+//	StoppingEvents and PassingEvents are only set where they are called
+//	every chain has an outgoing link or ends in a space whose Exits lead
+//	off it
+//	there are at most 64 star spaces
+//	there is at most one Start space
+//	every space can be reached from the Start space
+//
+//Boards without a Start space are allowed, their players start on Space
+//0 of Chain 0 as in InitializeGame. Moves made by events are followed
+//through Space.Exits, so spaces only reachable through an event need
+//their Exits set.
+func ValidateBoard(b Board) []error {
+	if b.Chains == nil || len(*b.Chains) == 0 {
+		return []error{fmt.Errorf("mp1: board has no chains")}
+	}
+	var errs []error
+	chains := *b.Chains
+	for ci, chain := range chains {
+		if len(chain) == 0 {
+			errs = append(errs, fmt.Errorf("mp1: chain %d has no spaces", ci))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	var start []ChainSpace
+	stars := 0
+	for ci, chain := range chains {
+		for si, space := range chain {
+			pos := ChainSpace{ci, si}
+			switch space.Type {
+			case Start:
+				start = append(start, pos)
+			case Star:
+				stars++
+			}
+			errs = append(errs, b.validateSpace(pos)...)
+		}
+	}
+	if len(start) > 1 {
+		errs = append(errs, fmt.Errorf("mp1: %d Start spaces at %v, "+
+			"at most 1 is allowed", len(start), start))
+	}
+	if stars > maxStarSpaces {
+		errs = append(errs, fmt.Errorf("mp1: %d star spaces, at most %d "+
+			"are allowed", stars, maxStarSpaces))
+	}
+
+	if b.Links != nil {
+		for chain, links := range *b.Links {
+			if chain < 0 || chain >= len(chains) {
+				errs = append(errs, fmt.Errorf("mp1: links of unknown "+
+					"chain %d", chain))
+				continue
+			}
+			if links == nil || len(*links) == 0 {
+				errs = append(errs, fmt.Errorf("mp1: chain %d has an "+
+					"empty list of links", chain))
+				continue
+			}
+			for _, link := range *links {
+				if !b.has(link) {
+					errs = append(errs, fmt.Errorf("mp1: chain %d links "+
+						"to unknown space %v", chain, link))
+				}
+			}
+		}
+	}
+	for ci, chain := range chains {
+		last := chain[len(chain)-1]
+		if b.link(ci) == nil &&
+			(last.PassingEvent == nil || len(last.Exits) == 0) {
+			errs = append(errs, fmt.Errorf("mp1: chain %d has no "+
+				"outgoing link", ci))
+		}
+	}
+	if len(errs) > 0 { //Walking the board needs valid links and Exits
+		return errs
+	}
+
+	root := ChainSpace{0, 0}
+	if len(start) == 1 {
+		root = start[0]
+	}
+	reached, landable := b.walk(root)
+	for ci, chain := range chains {
+		for si, space := range chain {
+			pos := ChainSpace{ci, si}
+			if !reached[pos] {
+				errs = append(errs, fmt.Errorf("mp1: space %v can not be "+
+					"reached from %v", pos, root))
+			} else if landable[pos] && space.Type == Invisible &&
+				space.PassingEvent == nil && space.StoppingEvent == nil {
+				errs = append(errs, fmt.Errorf("mp1: space %v is an "+
+					"Invisible space players can land on without a "+
+					"StoppingEvent", pos))
+			} else if landable[pos] && space.Type == Invisible &&
+				space.StoppingEvent != nil {
+				if err := b.checkStop(pos); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+	return errs
+}
+
+//checkStop runs the StoppingEvent of the Invisible space at pos on a new
+//game. ActivateSpace calls the event until it sets the landed space type,
+//so an event that leaves it Invisible never returns.
+func (b Board) checkStop(pos ChainSpace) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("mp1: space %v has a StoppingEvent that "+
+				"panics on a new game: %v", pos, p)
+		}
+	}()
+	g := InitializeGame(b, GameConfig{MaxTurns: 1})
+	g.Players[0].CurrentSpace = pos
+	g.Players[0].LastSpaceType = Invisible
+	(*b.Chains)[pos.Chain][pos.Space].StoppingEvent(g, 0)
+	if g.Players[0].LastSpaceType == Invisible {
+		return fmt.Errorf("mp1: space %v has a StoppingEvent that does "+
+			"not set the landed space type", pos)
+	}
+	return nil
+}
+
+//validateSpace checks the events and Exits of the space at pos.
+func (b Board) validateSpace(pos ChainSpace) []error {
+	var errs []error
+	space := (*b.Chains)[pos.Chain][pos.Space]
+	switch space.Type {
+	case Happening:
+		if space.StoppingEvent == nil {
+			errs = append(errs, fmt.Errorf("mp1: space %v is a Happening "+
+				"space without a StoppingEvent", pos))
+		}
+	case Invisible:
+	default:
+		if space.StoppingEvent != nil {
+			errs = append(errs, fmt.Errorf("mp1: space %v has a "+
+				"StoppingEvent that is never called on a %s space",
+				pos, space.Type))
+		}
+	}
+	if space.PassingEvent != nil && space.Type != Invisible {
+		errs = append(errs, fmt.Errorf("mp1: space %v has a PassingEvent "+
+			"that is never called on a %s space", pos, space.Type))
+	}
+	for _, exit := range space.Exits {
+		if !b.has(exit.Dest) {
+			errs = append(errs, fmt.Errorf("mp1: space %v exits to "+
+				"unknown space %v", pos, exit.Dest))
+		}
+	}
+	return errs
+}
+
+//has returns true if pos is a space of b.
+func (b Board) has(pos ChainSpace) bool {
+	chains := *b.Chains
+	return pos.Chain >= 0 && pos.Chain < len(chains) &&
+		pos.Space >= 0 && pos.Space < len(chains[pos.Chain])
+}
+
+//link returns the links at the end of chain, or nil if there are none.
+func (b Board) link(chain int) []ChainSpace {
+	if b.Links == nil {
+		return nil
+	}
+	links, ok := (*b.Links)[chain]
+	if !ok || links == nil {
+		return nil
+	}
+	return *links
+}
+
+//next returns the spaces a player on pos steps on with their next move,
+//following links as CheckLinks does.
+func (b Board) next(pos ChainSpace) []ChainSpace {
+	if pos.Space+1 < len((*b.Chains)[pos.Chain]) {
+		return []ChainSpace{{pos.Chain, pos.Space + 1}}
+	}
+	if links := b.link(pos.Chain); links != nil {
+		return links
+	}
+	if b.Links != nil { //CheckLinks wraps around to the chain's start
+		return []ChainSpace{{pos.Chain, 0}}
+	}
+	return nil
+}
+
+//walk returns every space reachable from root, by walking and through
+//Exits, and the spaces among them a player can end their movement on.
+func (b Board) walk(root ChainSpace) (reached, landable map[ChainSpace]bool) {
+	reached = map[ChainSpace]bool{root: true}
+	landable = map[ChainSpace]bool{}
+	passed := map[ChainSpace]bool{}
+	queue := []ChainSpace{root}
+	visit := func(pos ChainSpace, land bool) {
+		if land {
+			landable[pos] = true
+		}
+		if !reached[pos] {
+			reached[pos] = true
+			queue = append(queue, pos)
+		}
+	}
+	//enter steps onto pos, leaving through its Exits if it is passed.
+	var enter func(pos ChainSpace)
+	enter = func(pos ChainSpace) {
+		space := (*b.Chains)[pos.Chain][pos.Space]
+		if space.PassingEvent == nil || len(space.Exits) == 0 {
+			visit(pos, true)
+			return
+		}
+		if passed[pos] {
+			return
+		}
+		passed[pos] = true
+		reached[pos] = true
+		for _, exit := range space.Exits {
+			if exit.Dest == pos { //Keeps walking
+				for _, n := range b.next(pos) {
+					enter(n)
+				}
+			} else {
+				visit(exit.Dest, exit.Moves > 0)
+			}
+		}
+	}
+	for len(queue) > 0 {
+		pos := queue[0]
+		queue = queue[1:]
+		space := (*b.Chains)[pos.Chain][pos.Space]
+		if space.PassingEvent == nil {
+			for _, exit := range space.Exits {
+				visit(exit.Dest, false)
+			}
+		}
+		for _, n := range b.next(pos) {
+			enter(n)
+		}
+	}
+	return reached, landable
+}
@@ -0,0 +1,114 @@
+package mp1
+
+import (
+	"strings"
+	"testing"
+)
+
+//loopBoard returns a board of a single chain linking back to itself.
+func loopBoard(spaces ...Space) Board {
+	chain := append(Chain{{Type: Start}}, spaces...)
+	return Board{
+		Chains: &[]Chain{chain},
+		Links:  &map[int]*[]ChainSpace{0: {{0, 0}}},
+	}
+}
+
+func ErrorsContain(expected string, errs []error, t *testing.T) {
+	t.Helper()
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), expected) {
+		t.Errorf("Expected one error containing %q, got: %v", expected, errs)
+	}
+}
+
+func TestValidateBoard(t *testing.T) {
+	happening := func(g *Game, player int) {}
+	b := loopBoard(Space{Type: Blue}, Space{Type: Happening, StoppingEvent: happening})
+	if errs := ValidateBoard(b); errs != nil {
+		t.Errorf("Expected no errors, got: %v", errs)
+	}
+}
+
+func TestValidateBoardSpaces(t *testing.T) {
+	stop := func(g *Game, player int) {}
+	pass := func(g *Game, player, moves int) int { return moves }
+	ErrorsContain("Happening space without a StoppingEvent",
+		ValidateBoard(loopBoard(Space{Type: Happening})), t)
+	ErrorsContain("StoppingEvent that is never called",
+		ValidateBoard(loopBoard(Space{Type: Blue, StoppingEvent: stop})), t)
+	ErrorsContain("PassingEvent that is never called",
+		ValidateBoard(loopBoard(Space{Type: Red, PassingEvent: pass})), t)
+	ErrorsContain("Invisible space players can land on",
+		ValidateBoard(loopBoard(Space{Type: Invisible})), t)
+	ErrorsContain("does not set the landed space type",
+		ValidateBoard(loopBoard(Space{Type: Invisible, StoppingEvent: stop})), t)
+	ErrorsContain("2 Start spaces",
+		ValidateBoard(loopBoard(Space{Type: Start})), t)
+
+	stars := make([]Space, maxStarSpaces+1)
+	for i := range stars {
+		stars[i].Type = Star
+	}
+	ErrorsContain("65 star spaces", ValidateBoard(loopBoard(stars...)), t)
+}
+
+func TestValidateBoardLinks(t *testing.T) {
+	b := loopBoard(Space{Type: Blue})
+	b.Links = &map[int]*[]ChainSpace{0: {{1, 0}}}
+	ErrorsContain("links to unknown space", ValidateBoard(b), t)
+
+	b.Links = &map[int]*[]ChainSpace{0: {{0, 0}}, 1: {{0, 0}}}
+	ErrorsContain("links of unknown chain", ValidateBoard(b), t)
+
+	b.Links = nil
+	ErrorsContain("chain 0 has no outgoing link", ValidateBoard(b), t)
+
+	ErrorsContain("board has no chains", ValidateBoard(Board{}), t)
+	ErrorsContain("chain 0 has no spaces",
+		ValidateBoard(Board{Chains: &[]Chain{{}}}), t)
+}
+
+func TestValidateBoardReachability(t *testing.T) {
+	warp := func(g *Game, player, moves int) int {
+		g.Players[player].CurrentSpace = ChainSpace{1, 0}
+		return moves
+	}
+	b := Board{
+		Chains: &[]Chain{
+			{{Type: Start}, {Type: Blue}, {Type: Invisible, PassingEvent: warp}},
+			{{Type: Invisible}, {Type: Red}},
+		},
+		Links: &map[int]*[]ChainSpace{1: {{0, 0}}},
+	}
+	ErrorsContain("chain 0 has no outgoing link", ValidateBoard(b), t)
+
+	(*b.Chains)[0][2].Exits = []Exit{{Dest: ChainSpace{1, 5}}}
+	ErrorsContain("exits to unknown space", ValidateBoard(b), t)
+
+	//Warping to (1, 0) means players can not land on it
+	(*b.Chains)[0][2].Exits = []Exit{{Dest: ChainSpace{1, 0}}}
+	if errs := ValidateBoard(b); errs != nil {
+		t.Errorf("Expected no errors, got: %v", errs)
+	}
+
+	(*b.Chains)[0][2].Exits = []Exit{{Dest: ChainSpace{1, 0}, Moves: 1}}
+	ErrorsContain("space {1 0} is an Invisible space", ValidateBoard(b), t)
+
+	//An Exit back to itself lets players walk on, around to (0, 0)
+	(*b.Chains)[0][2].Exits = []Exit{{Dest: ChainSpace{0, 2}}}
+	errs := ValidateBoard(b)
+	if len(errs) != 2 || !strings.Contains(errs[0].Error(),
+		"space {1 0} can not be reached") {
+		t.Errorf("Expected chain 1 to be unreachable, got: %v", errs)
+	}
+}
+
+func TestValidateBoardNoStart(t *testing.T) {
+	b := Board{
+		Chains: &[]Chain{{{Type: Blue}, {Type: Red}}},
+		Links:  &map[int]*[]ChainSpace{0: {{0, 0}}},
+	}
+	if errs := ValidateBoard(b); errs != nil {
+		t.Errorf("Expected no errors, got: %v", errs)
+	}
+}
@@ -0,0 +1,63 @@
+package viz
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+//WriteDOT writes gr as a Graphviz digraph to w. Each chain is a cluster
+//of nodes filled with their space type's Color. Spaces with a
+//PassingEvent are diamonds, and spaces with a StoppingEvent have a
+//double border. Links are bold edges and moves made by events are
+//dashed. Players are listed under their space and the current star
+//space is outlined in gold.
+func (gr *Graph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph board {")
+	fmt.Fprintln(bw, "\trankdir=LR;")
+	fmt.Fprintln(bw, "\tnode [style=filled, shape=circle, fixedsize=true, "+
+		"width=0.5, fontsize=8];")
+	for ci, chain := range gr.Chains {
+		fmt.Fprintf(bw, "\tsubgraph cluster_%d {\n", ci)
+		fmt.Fprintf(bw, "\t\tlabel=\"Chain %d\";\n", ci)
+		for _, n := range chain {
+			fmt.Fprintf(bw, "\t\t%s [%s];\n", nodeID(n.Space), gr.dotAttrs(n))
+		}
+		fmt.Fprintln(bw, "\t}")
+	}
+	for _, e := range gr.Edges {
+		fmt.Fprintf(bw, "\t%s -> %s", nodeID(e.From), nodeID(e.To))
+		switch e.Kind {
+		case LinkEdge:
+			fmt.Fprint(bw, " [style=bold]")
+		case ExitEdge:
+			fmt.Fprint(bw, " [style=dashed, constraint=false]")
+		}
+		fmt.Fprintln(bw, ";")
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+//dotAttrs returns the DOT attributes of n.
+func (gr *Graph) dotAttrs(n Node) string {
+	label := fmt.Sprintf("%d,%d", n.Space.Chain, n.Space.Space)
+	attrs := "label=" + strconv.Quote(label) +
+		", tooltip=" + strconv.Quote(n.Type.String()) +
+		", fillcolor=" + strconv.Quote(Color(n.Type))
+	if n.Passing {
+		attrs += ", shape=diamond"
+	}
+	if n.Stopping {
+		attrs += ", peripheries=2"
+	}
+	if n.Star {
+		attrs += ", color=\"#d4a800\", penwidth=4"
+	}
+	if len(n.Players) > 0 {
+		attrs += ", xlabel=" + strconv.Quote(gr.playerLabel(n.Players))
+	}
+	return attrs
+}
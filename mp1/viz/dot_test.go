@@ -0,0 +1,52 @@
+package viz
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	"github.com/0xhexnumbers/partysim/mp1/board"
+)
+
+func TestWriteDOT(t *testing.T) {
+	g := mp1.InitializeGame(board.MRC, mp1.GameConfig{MaxTurns: 20})
+	g.Players[3].Char = "Yoshi"
+	g.Players[3].CurrentSpace = mp1.NewChainSpace(4, 2)
+	var buf bytes.Buffer
+	if err := GameGraph(g).WriteDOT(&buf); err != nil {
+		t.Fatal(err)
+	}
+	dot := buf.String()
+	for _, s := range []string{
+		"digraph board {",
+		"subgraph cluster_4 {",
+		"c0s7 [label=\"0,7\", tooltip=\"Happening\", fillcolor=\"#3bb04a\", peripheries=2];",
+		"c4s8 [label=\"4,8\", tooltip=\"Invisible\", fillcolor=\"#dddddd\", shape=diamond];",
+		"c4s2 [label=\"4,2\", tooltip=\"Blue\", fillcolor=\"#3b6fe0\", xlabel=\"Yoshi\"];",
+		"xlabel=\"P1, P2, P3\"",
+		"c0s15 -> c1s0 [style=bold];",
+		"c4s8 -> c0s0 [style=dashed, constraint=false];",
+		"c0s0 -> c0s1;",
+	} {
+		if !strings.Contains(dot, s) {
+			t.Errorf("Expected DOT to contain %q", s)
+		}
+	}
+	if !strings.HasSuffix(dot, "}\n") {
+		t.Errorf("Expected DOT to end the digraph, got: %q", dot[len(dot)-10:])
+	}
+}
+
+func TestWriteDOTStar(t *testing.T) {
+	gr := NewGraph(board.MRC)
+	gr.Chains[1][3].Star = true
+	var buf bytes.Buffer
+	if err := gr.WriteDOT(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "c1s3 [label=\"1,3\", tooltip=\"Chance\", "+
+		"fillcolor=\"#ffffff\", color=\"#d4a800\", penwidth=4];") {
+		t.Errorf("Expected the star space to be outlined, got: %s", buf.String())
+	}
+}
@@ -0,0 +1,162 @@
+package viz
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//Sizes of the SVG layout, in pixels.
+const (
+	cellSize   = 48
+	nodeRadius = 14
+	marginLeft = 80
+	marginTop  = 40
+)
+
+//Layout places every space of gr on a grid, one row per chain. A chain
+//starts one column after the space leading into it, so a player's path
+//reads left to right. It returns the column of each chain's first space.
+func (gr *Graph) Layout() []int {
+	cols := make([]int, len(gr.Chains))
+	placed := make([]bool, len(gr.Chains))
+	queue := []int{0}
+	placed[0] = true
+	for start := 0; start < len(gr.Chains); start++ {
+		if !placed[start] { //Not reachable from chain 0
+			placed[start] = true
+			queue = append(queue, start)
+		}
+		for len(queue) > 0 {
+			c := queue[0]
+			queue = queue[1:]
+			for _, e := range gr.Edges {
+				to := e.To.Chain
+				if e.From.Chain != c || placed[to] {
+					continue
+				}
+				col := cols[c] + e.From.Space + 1 - e.To.Space
+				if col < 0 {
+					col = 0
+				}
+				cols[to] = col
+				placed[to] = true
+				queue = append(queue, to)
+			}
+		}
+	}
+	return cols
+}
+
+//point returns the center of pos in the SVG.
+func point(cols []int, pos mp1.ChainSpace) (x, y int) {
+	x = marginLeft + (cols[pos.Chain]+pos.Space)*cellSize + cellSize/2
+	y = marginTop + pos.Chain*cellSize + cellSize/2
+	return x, y
+}
+
+//WriteSVG writes gr as a standalone SVG image to w, laid out by Layout.
+//It is drawn like WriteDOT: spaces are filled with their type's Color,
+//spaces with a PassingEvent are diamonds, spaces with a StoppingEvent
+//have a double border, links are thick and moves made by events are
+//dashed. Players are named above their space and the current star space
+//has a gold ring.
+func (gr *Graph) WriteSVG(w io.Writer) error {
+	cols := gr.Layout()
+	width, height := 0, marginTop+len(gr.Chains)*cellSize+marginTop
+	for ci, chain := range gr.Chains {
+		x, _ := point(cols, mp1.NewChainSpace(ci, len(chain)-1))
+		if x+cellSize > width {
+			width = x + cellSize
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" `+
+		`width="%d" height="%d" viewBox="0 0 %d %d" `+
+		`font-family="sans-serif">`+"\n", width, height, width, height)
+	fmt.Fprintln(bw, `<defs><marker id="arrow" viewBox="0 0 10 10" `+
+		`refX="10" refY="5" markerWidth="6" markerHeight="6" `+
+		`orient="auto"><path d="M0,0 L10,5 L0,10 z" fill="#444"/>`+
+		`</marker></defs>`)
+	for ci := range gr.Chains {
+		_, y := point(cols, mp1.NewChainSpace(ci, 0))
+		fmt.Fprintf(bw, `<text x="4" y="%d" font-size="11">Chain %d</text>`+
+			"\n", y+4, ci)
+	}
+	for _, e := range gr.Edges {
+		gr.writeSVGEdge(bw, cols, e)
+	}
+	for _, chain := range gr.Chains {
+		for _, n := range chain {
+			gr.writeSVGNode(bw, cols, n)
+		}
+	}
+	fmt.Fprintln(bw, "</svg>")
+	return bw.Flush()
+}
+
+//writeSVGEdge draws e as an arrow between the borders of its spaces.
+func (gr *Graph) writeSVGEdge(w io.Writer, cols []int, e Edge) {
+	x1, y1 := point(cols, e.From)
+	x2, y2 := point(cols, e.To)
+	style := `stroke="#444" stroke-width="1"`
+	switch e.Kind {
+	case LinkEdge:
+		style = `stroke="#444" stroke-width="2.5"`
+	case ExitEdge:
+		style = `stroke="#888" stroke-width="1.5" stroke-dasharray="4 3"`
+	}
+	if e.Kind == ChainEdge {
+		fmt.Fprintf(w, `<line x1="%d" y1="%d" x2="%d" y2="%d" %s `+
+			`marker-end="url(#arrow)"/>`+"\n",
+			x1+nodeRadius, y1, x2-nodeRadius, y2, style)
+		return
+	}
+	//Links and exits jump between rows, so they curve around the spaces
+	//in between.
+	cx, cy := (x1+x2)/2, (y1+y2)/2-cellSize
+	if y1 != y2 {
+		cx = (x1+x2)/2 + cellSize
+	}
+	fmt.Fprintf(w, `<path d="M%d,%d Q%d,%d %d,%d" fill="none" %s `+
+		`marker-end="url(#arrow)"/>`+"\n",
+		x1, y1-nodeRadius, cx, cy, x2, y2-nodeRadius, style)
+}
+
+//writeSVGNode draws n and its overlay.
+func (gr *Graph) writeSVGNode(w io.Writer, cols []int, n Node) {
+	x, y := point(cols, n.Space)
+	r := nodeRadius
+	fmt.Fprintf(w, `<g id="%s"><title>%s %d,%d</title>`,
+		nodeID(n.Space), html.EscapeString(n.Type.String()),
+		n.Space.Chain, n.Space.Space)
+	if n.Star {
+		fmt.Fprintf(w, `<circle cx="%d" cy="%d" r="%d" fill="none" `+
+			`stroke="#d4a800" stroke-width="4"/>`, x, y, r+6)
+	}
+	if n.Stopping {
+		fmt.Fprintf(w, `<circle cx="%d" cy="%d" r="%d" fill="none" `+
+			`stroke="#222"/>`, x, y, r+3)
+	}
+	if n.Passing {
+		fmt.Fprintf(w, `<polygon points="%d,%d %d,%d %d,%d %d,%d" `+
+			`fill="%s" stroke="#222"/>`,
+			x, y-r, x+r, y, x, y+r, x-r, y, Color(n.Type))
+	} else {
+		fmt.Fprintf(w, `<circle cx="%d" cy="%d" r="%d" fill="%s" `+
+			`stroke="#222"/>`, x, y, r, Color(n.Type))
+	}
+	fmt.Fprintf(w, `<text x="%d" y="%d" font-size="8" `+
+		`text-anchor="middle">%d,%d</text>`,
+		x, y+3, n.Space.Chain, n.Space.Space)
+	if len(n.Players) > 0 {
+		fmt.Fprintf(w, `<text x="%d" y="%d" font-size="10" `+
+			`font-weight="bold" text-anchor="middle">%s</text>`,
+			x, y-r-8, html.EscapeString(gr.playerLabel(n.Players)))
+	}
+	fmt.Fprintln(w, "</g>")
+}
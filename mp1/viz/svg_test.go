@@ -0,0 +1,73 @@
+package viz
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	"github.com/0xhexnumbers/partysim/mp1/board"
+)
+
+//CountElements parses svg as XML and counts its elements by name.
+func CountElements(svg []byte, t *testing.T) map[string]int {
+	t.Helper()
+	count := map[string]int{}
+	d := xml.NewDecoder(bytes.NewReader(svg))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return count
+		}
+		if err != nil {
+			t.Fatalf("Expected well-formed SVG, got: %v", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			count[start.Name.Local]++
+		}
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	g := mp1.InitializeGame(board.MRC, mp1.GameConfig{MaxTurns: 20})
+	g.Players[0].Char = "Peach & Daisy"
+	var buf bytes.Buffer
+	if err := GameGraph(g).WriteSVG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	count := CountElements(buf.Bytes(), t)
+	expected := map[string]int{
+		"svg":  1,
+		"g":    57,
+		"line": 52,
+		//6 links, 1 Exit and the marker's arrowhead
+		"path": 8,
+		//The castle is a diamond
+		"polygon": 1,
+		//56 spaces and 5 Happening double borders
+		"circle": 61,
+		//5 chain labels, 57 space labels and 1 player label
+		"text": 63,
+	}
+	for name, n := range expected {
+		if count[name] != n {
+			t.Errorf("Expected %d %s elements, got: %d", n, name, count[name])
+		}
+	}
+}
+
+func TestWriteSVGStar(t *testing.T) {
+	b := mp1.Board{
+		Chains: &[]mp1.Chain{{{Type: mp1.Start}, {Type: mp1.Star}}},
+		Links:  &map[int]*[]mp1.ChainSpace{0: {mp1.NewChainSpace(0, 0)}},
+	}
+	var buf bytes.Buffer
+	if err := GameGraph(mp1.InitializeGame(b, mp1.GameConfig{})).WriteSVG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	//2 spaces and the star's ring
+	if count := CountElements(buf.Bytes(), t); count["circle"] != 3 {
+		t.Errorf("Expected 3 circles, got: %d", count["circle"])
+	}
+}
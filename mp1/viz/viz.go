@@ -0,0 +1,183 @@
+//Package viz renders the chain and link graph of mp1 boards as Graphviz
+//DOT or as SVG, optionally marking where the players and the star are in
+//a game.
+package viz
+
+import (
+	"fmt"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+)
+
+//EdgeKind tells how a player gets from one space to another.
+type EdgeKind int
+
+const (
+	//ChainEdge is a step to the next space of a chain.
+	ChainEdge EdgeKind = iota
+	//LinkEdge is a step from the end of a chain through Board.Links.
+	LinkEdge
+	//ExitEdge is a move made by a space's event, from Space.Exits.
+	ExitEdge
+)
+
+//Node is a space of the board.
+type Node struct {
+	Space       mp1.ChainSpace
+	Type        mp1.SpaceType
+	HiddenBlock bool
+	//Passing and Stopping are true if the space has a PassingEvent or a
+	//StoppingEvent.
+	Passing  bool
+	Stopping bool
+
+	//Players are the indexes of the players on the space, only set by
+	//GameGraph.
+	Players []int
+	//Star is true if the space is the current star space, only set by
+	//GameGraph.
+	Star bool
+}
+
+//Edge is a way from one space to another.
+type Edge struct {
+	From, To mp1.ChainSpace
+	Kind     EdgeKind
+}
+
+//Graph is the graph of a board, optionally overlaid with a game's state.
+type Graph struct {
+	Chains [][]Node
+	Edges  []Edge
+	//Names are the names of the players, used to label them.
+	Names [4]string
+}
+
+//NewGraph returns the graph of b.
+func NewGraph(b mp1.Board) *Graph {
+	gr := &Graph{}
+	for i := range gr.Names {
+		gr.Names[i] = fmt.Sprintf("P%d", i+1)
+	}
+	chains := *b.Chains
+	for ci, chain := range chains {
+		nodes := make([]Node, len(chain))
+		for si, space := range chain {
+			pos := mp1.NewChainSpace(ci, si)
+			nodes[si] = Node{
+				Space:       pos,
+				Type:        space.Type,
+				HiddenBlock: space.HiddenBlock,
+				Passing:     space.PassingEvent != nil,
+				Stopping:    space.StoppingEvent != nil,
+			}
+			if si+1 < len(chain) {
+				gr.Edges = append(gr.Edges, Edge{
+					pos, mp1.NewChainSpace(ci, si+1), ChainEdge,
+				})
+			}
+			for _, exit := range space.Exits {
+				if exit.Dest != pos {
+					gr.Edges = append(gr.Edges, Edge{pos, exit.Dest, ExitEdge})
+				}
+			}
+		}
+		gr.Chains = append(gr.Chains, nodes)
+	}
+	if b.Links == nil {
+		return gr
+	}
+	for ci, chain := range chains {
+		last := mp1.NewChainSpace(ci, len(chain)-1)
+		links, ok := (*b.Links)[ci]
+		end := chain[len(chain)-1]
+		if !ok && end.PassingEvent != nil && len(end.Exits) > 0 {
+			continue //Players always leave through the Exits
+		}
+		if !ok { //CheckLinks wraps around to the chain's start
+			gr.Edges = append(gr.Edges, Edge{
+				last, mp1.NewChainSpace(ci, 0), LinkEdge,
+			})
+			continue
+		}
+		for _, link := range *links {
+			gr.Edges = append(gr.Edges, Edge{last, link, LinkEdge})
+		}
+	}
+	return gr
+}
+
+//GameGraph returns the graph of g's board, marking each player's
+//CurrentSpace and the current star space.
+func GameGraph(g *mp1.Game) *Graph {
+	gr := NewGraph(g.Board)
+	for i, p := range g.Players {
+		if p.Char != "" {
+			gr.Names[i] = p.Char
+		}
+		if n := gr.node(p.CurrentSpace); n != nil {
+			n.Players = append(n.Players, i)
+		}
+	}
+	if g.StarSpaces.StarSpaceCount > 0 {
+		if n := gr.node(g.StarSpaces.CurrentStarSpace); n != nil {
+			n.Star = true
+		}
+	}
+	return gr
+}
+
+//node returns the node of pos, or nil if pos is not on the board.
+func (gr *Graph) node(pos mp1.ChainSpace) *Node {
+	if pos.Chain < 0 || pos.Chain >= len(gr.Chains) ||
+		pos.Space < 0 || pos.Space >= len(gr.Chains[pos.Chain]) {
+		return nil
+	}
+	return &gr.Chains[pos.Chain][pos.Space]
+}
+
+//Color returns the fill color of spaces of type t.
+func Color(t mp1.SpaceType) string {
+	switch t {
+	case mp1.Blue:
+		return "#3b6fe0"
+	case mp1.Red:
+		return "#e03b3b"
+	case mp1.MinigameSpace:
+		return "#f2a7d8"
+	case mp1.Happening:
+		return "#3bb04a"
+	case mp1.Star:
+		return "#f5d63d"
+	case mp1.Chance:
+		return "#ffffff"
+	case mp1.Start:
+		return "#9be3f0"
+	case mp1.Mushroom:
+		return "#f08c3b"
+	case mp1.Bowser:
+		return "#7a1f1f"
+	case mp1.BogusItem:
+		return "#8a8a8a"
+	case mp1.Boo:
+		return "#b9a6e6"
+	}
+	return "#dddddd" //Invisible
+}
+
+//nodeID returns the DOT and SVG id of pos.
+func nodeID(pos mp1.ChainSpace) string {
+	return fmt.Sprintf("c%ds%d", pos.Chain, pos.Space)
+}
+
+//playerLabel returns the names of players joined by commas.
+func (gr *Graph) playerLabel(players []int) string {
+	label := ""
+	for i, p := range players {
+		if i > 0 {
+			label += ", "
+		}
+		label += gr.Names[p]
+	}
+	return label
+}
@@ -0,0 +1,113 @@
+package viz
+
+import (
+	"testing"
+
+	"github.com/0xhexnumbers/partysim/mp1"
+	"github.com/0xhexnumbers/partysim/mp1/board"
+)
+
+//CountEdges returns the number of edges of each kind in gr.
+func CountEdges(gr *Graph) map[EdgeKind]int {
+	count := map[EdgeKind]int{}
+	for _, e := range gr.Edges {
+		count[e.Kind]++
+	}
+	return count
+}
+
+func TestNewGraph(t *testing.T) {
+	gr := NewGraph(board.MRC)
+	nodes := 0
+	for _, chain := range gr.Chains {
+		nodes += len(chain)
+	}
+	if nodes != 57 {
+		t.Errorf("Expected 57 nodes, got: %d", nodes)
+	}
+	//Chain 4 has no links, its castle always sends players to (0, 0)
+	expected := map[EdgeKind]int{ChainEdge: 52, LinkEdge: 6, ExitEdge: 1}
+	if count := CountEdges(gr); count[ChainEdge] != expected[ChainEdge] ||
+		count[LinkEdge] != expected[LinkEdge] ||
+		count[ExitEdge] != expected[ExitEdge] {
+		t.Errorf("Expected edges %v, got: %v", expected, count)
+	}
+	castle := gr.Chains[4][8]
+	if !castle.Passing || castle.Stopping || castle.Type != mp1.Invisible {
+		t.Errorf("Expected castle to be a passing Invisible node, got: %+v",
+			castle)
+	}
+	if n := gr.Chains[0][7]; !n.Stopping || n.Passing {
+		t.Errorf("Expected Happening node to be stopping, got: %+v", n)
+	}
+}
+
+func TestNewGraphWrap(t *testing.T) {
+	b := mp1.Board{
+		Chains: &[]mp1.Chain{
+			{{Type: mp1.Start}, {Type: mp1.Blue}},
+			{{Type: mp1.Red}},
+		},
+		Links: &map[int]*[]mp1.ChainSpace{0: {mp1.NewChainSpace(1, 0)}},
+	}
+	gr := NewGraph(b)
+	wrap := Edge{mp1.NewChainSpace(1, 0), mp1.NewChainSpace(1, 0), LinkEdge}
+	if len(gr.Edges) != 3 || gr.Edges[2] != wrap {
+		t.Errorf("Expected chain 1 to wrap around, got: %v", gr.Edges)
+	}
+
+	b.Links = nil
+	if gr := NewGraph(b); CountEdges(gr)[LinkEdge] != 0 {
+		t.Errorf("Expected no links, got: %v", gr.Edges)
+	}
+}
+
+func TestGameGraph(t *testing.T) {
+	b := mp1.Board{
+		Chains: &[]mp1.Chain{
+			{{Type: mp1.Start}, {Type: mp1.Star}, {Type: mp1.Blue}, {Type: mp1.Star}},
+		},
+		Links: &map[int]*[]mp1.ChainSpace{0: {mp1.NewChainSpace(0, 0)}},
+	}
+	g := mp1.InitializeGame(b, mp1.GameConfig{MaxTurns: 20})
+	g.Players[0].Char = "Mario"
+	g.Players[2].CurrentSpace = mp1.NewChainSpace(0, 2)
+	g.StarSpaces.CurrentStarSpace = mp1.NewChainSpace(0, 3)
+
+	gr := GameGraph(g)
+	if label := gr.playerLabel(gr.Chains[0][0].Players); label != "Mario, P2, P4" {
+		t.Errorf("Expected Mario, P2, P4 on Start, got: %q", label)
+	}
+	if players := gr.Chains[0][2].Players; len(players) != 1 || players[0] != 2 {
+		t.Errorf("Expected player 2 on (0, 2), got: %v", players)
+	}
+	for si, n := range gr.Chains[0] {
+		if n.Star != (si == 3) {
+			t.Errorf("Expected only (0, 3) to be the star, got: %+v", n)
+		}
+	}
+}
+
+func TestGameGraphNoStars(t *testing.T) {
+	g := mp1.InitializeGame(board.MRC, mp1.GameConfig{MaxTurns: 20})
+	gr := GameGraph(g)
+	if n := gr.Chains[0][0]; n.Star {
+		t.Errorf("Expected no star without star spaces, got: %+v", n)
+	}
+	if players := gr.Chains[0][1].Players; len(players) != 4 {
+		t.Errorf("Expected all players on Start, got: %v", players)
+	}
+}
+
+func TestLayout(t *testing.T) {
+	cols := NewGraph(board.MRC).Layout()
+	//Chain 0 forks into 1 and 2 after its 16 spaces, chain 2 forks into 3
+	//and 4 after its 13 spaces.
+	expected := []int{0, 16, 16, 29, 29}
+	for i := range expected {
+		if cols[i] != expected[i] {
+			t.Errorf("Expected columns %v, got: %v", expected, cols)
+			break
+		}
+	}
+}